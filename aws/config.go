@@ -1,14 +1,24 @@
 package aws
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -163,8 +173,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/httprecorder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"golang.org/x/net/http/httpproxy"
 )
 
 type Config struct {
@@ -175,6 +189,9 @@ type Config struct {
 	Token         string
 	Region        string
 	MaxRetries    int
+	MaxBackoff    int
+	RetryMode     string
+	RateLimits    []*RateLimitConfig
 
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
@@ -185,12 +202,22 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentityARN                  string
+	AssumeRoleWithWebIdentityDurationSeconds      int
+	AssumeRoleWithWebIdentityPolicyARNs           []string
+	AssumeRoleWithWebIdentitySessionName          string
+	AssumeRoleWithWebIdentityWebIdentityToken     string
+	AssumeRoleWithWebIdentityWebIdentityTokenFile string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	CustomCABundle    string
+	DefaultTagsConfig *keyvaluetags.DefaultConfig
+	Endpoints         map[string]string
+	HTTPProxy         string
+	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+	Insecure          bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -199,9 +226,20 @@ type Config struct {
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 
+	// HTTPRecorder, when set, records AWS API interactions to or replays
+	// them from a fixture file for testing.
+	HTTPRecorder *httprecorder.Recorder
+
 	terraformVersion string
 }
 
+// RateLimitConfig is a client-side rate limit for the requests to a service.
+type RateLimitConfig struct {
+	Service           string
+	RequestsPerSecond float64
+	Burst             int
+}
+
 type AWSClient struct {
 	accessanalyzerconn                  *accessanalyzer.AccessAnalyzer
 	accountid                           string
@@ -246,6 +284,7 @@ type AWSClient struct {
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
 	daxconn                             *dax.DAX
+	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
@@ -424,11 +463,59 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
-	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	// With a proxy, custom CA bundle or HTTP recorder, all requests, including
+	// those resolving and validating credentials, use the same HTTP client.
+	var httpClient *http.Client
+
+	if c.HTTPProxy != "" || c.CustomCABundle != "" || c.HTTPRecorder != nil {
+		var err error
+
+		httpClient, err = c.httpClient()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	if c.HTTPRecorder != nil {
+		log.Printf("[INFO] HTTP recorder enabled (mode: %s)", c.HTTPRecorder.Mode())
+		httpClient.Transport = c.HTTPRecorder.Wrap(httpClient.Transport)
+	}
+
+	var webIdentityCreds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		var err error
+
+		webIdentityCreds, err = c.webIdentityCredentials(httpClient)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		if _, err := webIdentityCreds.Get(); err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: error assuming IAM Role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityARN, err)
+		}
+	}
+
+	var sess *session.Session
+	var accountID, partition string
+	var err error
+
+	// awsbase only accepts static source credentials, so the refreshing web
+	// identity credentials are chained into any assume_role here instead.
+	if httpClient != nil || webIdentityCreds != nil {
+		sess, accountID, partition, err = c.sessionWithCredentials(awsbaseConfig, httpClient, webIdentityCreds)
+	} else {
+		sess, accountID, partition, err = awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	c.configureRetries(sess)
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -486,6 +573,7 @@ func (c *Config) Client() (interface{}, error) {
 		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
 		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
 		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
+		DefaultTagsConfig:                   c.DefaultTagsConfig,
 		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
 		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
 		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
@@ -496,7 +584,6 @@ func (c *Config) Client() (interface{}, error) {
 		dynamodbconn:                        dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])})),
 		ec2conn:                             ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])})),
 		ecrconn:                             ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])})),
-		ecrpublicconn:                       ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecrpublic"])})),
 		ecsconn:                             ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])})),
 		efsconn:                             efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])})),
 		eksconn:                             eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])})),
@@ -549,7 +636,6 @@ func (c *Config) Client() (interface{}, error) {
 		mwaaconn:                            mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mwaa"])})),
 		neptuneconn:                         neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])})),
 		networkfirewallconn:                 networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkfirewall"])})),
-		networkmanagerconn:                  networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkmanager"])})),
 		opsworksconn:                        opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])})),
 		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
 		outpostsconn:                        outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["outposts"])})),
@@ -565,7 +651,6 @@ func (c *Config) Client() (interface{}, error) {
 		region:                              c.Region,
 		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
 		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
-		route53domainsconn:                  route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
 		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
 		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
 		s3outpostsconn:                      s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3outposts"])})),
@@ -601,12 +686,21 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	// "Global" services that require customizations
+	ecrpublicConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["ecrpublic"]),
+	}
 	globalAcceleratorConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["globalaccelerator"]),
 	}
+	networkManagerConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["networkmanager"]),
+	}
 	route53Config := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["route53"]),
 	}
+	route53DomainsConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["route53domains"]),
+	}
 	shieldConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["shield"]),
 	}
@@ -625,8 +719,11 @@ func (c *Config) Client() (interface{}, error) {
 	// Force "global" services to correct regions
 	switch partition {
 	case endpoints.AwsPartitionID:
+		ecrpublicConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
+		networkManagerConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
+		route53DomainsConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
 	case endpoints.AwsCnPartitionID:
 		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
@@ -639,158 +736,469 @@ func (c *Config) Client() (interface{}, error) {
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
+	client.ecrpublicconn = ecrpublic.New(sess.Copy(ecrpublicConfig))
 	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
+	client.networkmanagerconn = networkmanager.New(sess.Copy(networkManagerConfig))
 	client.r53conn = route53.New(sess.Copy(route53Config))
+	client.route53domainsconn = route53domains.New(sess.Copy(route53DomainsConfig))
 	client.shieldconn = shield.New(sess.Copy(shieldConfig))
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	client.appautoscalingconn.Handlers.Retry.PushBack(retryRules{
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		{
+			OperationPrefixes: []string{"Describe", "List"},
+			Code:              applicationautoscaling.ErrCodeFailedResourceAccessException,
+		},
+	}.Handler)
 
-	client.appsyncconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	client.appsyncconn.Handlers.Retry.PushBack(retryRules{
+		{
+			Operations: []string{"CreateGraphqlApi"},
+			Code:       appsync.ErrCodeConcurrentModificationException,
+			Message:    "a GraphQL API creation is already in progress",
+		},
+	}.Handler)
 
-	client.configconn.Handlers.Retry.PushBack(func(r *request.Request) {
+	client.configconn.Handlers.Retry.PushBack(retryRules{
 		// When calling Config Organization Rules API actions immediately
 		// after Organization creation, the API can randomly return the
 		// OrganizationAccessDeniedException error for a few minutes, even
 		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
+		// We only want to retry briefly as the default max retry count would
+		// excessively retry when the error could be legitimate.
+		// We currently depend on the DefaultRetryer exponential backoff here.
+		// ~10 retries gives a fair backoff of a few seconds.
+		{
+			Operations:    []string{"DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule"},
+			Code:          configservice.ErrCodeOrganizationAccessDeniedException,
+			Message:       "This action can be only made by AWS Organization's master account.",
+			MaxRetryCount: 9,
+		},
+	}.Handler)
 
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
+	client.dynamodbconn.Handlers.Retry.PushBack(retryRules{
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		{
+			Operations: []string{"PutItem", "UpdateItem", "DeleteItem"},
+			Code:       dynamodb.ErrCodeLimitExceededException,
+			Message:    "Subscriber limit exceeded:",
+		},
+	}.Handler)
+
+	client.ec2conn.Handlers.Retry.PushBack(retryRules{
+		{
+			Operations: []string{"CreateClientVpnEndpoint"},
+			Code:       "OperationNotPermitted",
+			Message:    "Endpoint cannot be created while another endpoint is being created",
+		},
+		{
+			Operations: []string{"CreateVpnConnection"},
+			Code:       "VpnConnectionLimitExceeded",
+			Message:    "maximum number of mutating objects has been reached",
+		},
+		{
+			Operations: []string{"CreateVpnGateway"},
+			Code:       "VpnGatewayLimitExceeded",
+			Message:    "maximum number of mutating objects has been reached",
+		},
+		{
+			Operations: []string{"AttachVpnGateway", "DetachVpnGateway"},
+			Code:       "InvalidParameterValue",
+			Message:    "This call cannot be completed because there are pending VPNs or Virtual Interfaces",
+		},
+	}.Handler)
+
+	client.kafkaconn.Handlers.Retry.PushBack(retryRules{
+		{
+			Code:    kafka.ErrCodeTooManyRequestsException,
+			Message: "Too Many Requests",
+		},
+	}.Handler)
+
+	client.kinesisconn.Handlers.Retry.PushBack(retryRules{
+		{
+			Operations: []string{"CreateStream"},
+			Code:       kinesis.ErrCodeLimitExceededException,
+			Message:    "simultaneously be in CREATING or DELETING",
+		},
+		{
+			Operations: []string{"CreateStream", "DeleteStream"},
+			Code:       kinesis.ErrCodeLimitExceededException,
+			Message:    "Rate exceeded for stream",
+		},
+	}.Handler)
+
+	client.organizationsconn.Handlers.Retry.PushBack(retryRules{
+		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+		{
+			Code:    organizations.ErrCodeConcurrentModificationException,
+			Message: "Try again later",
+		},
+	}.Handler)
+
+	client.storagegatewayconn.Handlers.Retry.PushBack(retryRules{
+		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+		{
+			Code:    storagegateway.ErrCodeInvalidGatewayRequestException,
+			Message: "The specified gateway proxy network connection is busy",
+		},
+	}.Handler)
+
+	client.wafv2conn.Handlers.Retry.PushBack(retryRules{
+		{
+			Code:    wafv2.ErrCodeWAFInternalErrorException,
+			Message: "Retry your request",
+		},
+		{
+			Code:    wafv2.ErrCodeWAFServiceLinkedRoleErrorException,
+			Message: "Retry",
+		},
+		// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+		{
+			Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+			Code:       wafv2.ErrCodeWAFTagOperationException,
+			Message:    "Retry your request",
+		},
+		{
+			Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+			Code:       wafv2.ErrCodeWAFTagOperationInternalErrorException,
+			Message:    "Retry your request",
+		},
+	}.Handler)
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.supportedplatforms = supportedPlatforms
 		}
+	}
+
+	return client, nil
+}
+
+// webIdentityCredentials returns credentials from sts:AssumeRoleWithWebIdentity
+// using the configured web identity token or token file.
+func (c *Config) webIdentityCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	var tokenFetcher stscreds.TokenFetcher
+
+	switch {
+	case c.AssumeRoleWithWebIdentityWebIdentityToken != "":
+		tokenFetcher = webIdentityToken(c.AssumeRoleWithWebIdentityWebIdentityToken)
+	case c.AssumeRoleWithWebIdentityWebIdentityTokenFile != "":
+		tokenFetcher = stscreds.FetchTokenPath(c.AssumeRoleWithWebIdentityWebIdentityTokenFile)
+	default:
+		return nil, fmt.Errorf("one of web_identity_token or web_identity_token_file must be configured in assume_role_with_web_identity")
+	}
+
+	if httpClient == nil {
+		httpClient = cleanhttp.DefaultClient()
+	}
+
+	// AssumeRoleWithWebIdentity is an unsigned request, so no source
+	// credentials are necessary.
+	stsSess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.Endpoints["sts"]),
+		HTTPClient:  httpClient,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
 	})
 
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
+	if err != nil {
+		return nil, fmt.Errorf("error creating web identity session: %w", err)
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithToken(sts.New(stsSess), c.AssumeRoleWithWebIdentityARN, c.AssumeRoleWithWebIdentitySessionName, tokenFetcher)
+
+	if c.AssumeRoleWithWebIdentityDurationSeconds > 0 {
+		provider.Duration = time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second
+	}
+
+	if len(c.AssumeRoleWithWebIdentityPolicyARNs) > 0 {
+		for _, policyARN := range c.AssumeRoleWithWebIdentityPolicyARNs {
+			provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
 		}
-	})
+	}
 
-	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateClientVpnEndpoint" {
-			if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
+	return credentials.NewCredentials(provider), nil
+}
+
+// httpClient returns an HTTP client honoring the http_proxy, custom_ca_bundle
+// and insecure provider arguments.
+func (c *Config) httpClient() (*http.Client, error) {
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CustomCABundle != "" {
+		pemCerts, err := customCABundlePEM(c.CustomCABundle)
+
+		if err != nil {
+			return nil, err
 		}
 
-		if r.Operation.Name == "CreateVpnConnection" {
-			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
+		rootCAs, err := x509.SystemCertPool()
+
+		if err != nil {
+			log.Printf("[WARN] Unable to load system root certificates, using only custom_ca_bundle: %s", err)
+			rootCAs = x509.NewCertPool()
 		}
 
-		if r.Operation.Name == "CreateVpnGateway" {
-			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
+		if !rootCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in custom_ca_bundle")
 		}
 
-		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
-			if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if c.HTTPProxy != "" {
+		if _, err := url.Parse(c.HTTPProxy); err != nil {
+			return nil, fmt.Errorf("error parsing http_proxy: %w", err)
 		}
-	})
+	}
+
+	transport.Proxy = httpProxyFunc(c.HTTPProxy)
+
+	return httpClient, nil
+}
+
+// httpProxyFunc returns a proxy function for the given http_proxy URL,
+// falling back to the HTTP_PROXY and HTTPS_PROXY environment variables as
+// http.ProxyFromEnvironment does. Requests to hosts matching NO_PROXY, to
+// loopback addresses and to link-local addresses, such as the EC2 instance
+// metadata service, are never proxied.
+// Any user information in the proxy URL is sent as proxy basic authentication.
+func httpProxyFunc(httpProxy string) func(*http.Request) (*url.URL, error) {
+	proxyConfig := httpproxy.FromEnvironment()
 
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
+	if httpProxy != "" {
+		proxyConfig.HTTPProxy = httpProxy
+		proxyConfig.HTTPSProxy = httpProxy
+	}
+
+	proxyFunc := proxyConfig.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		if ip := net.ParseIP(req.URL.Hostname()); ip != nil && (ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast()) {
+			return nil, nil
 		}
-	})
 
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
+		return proxyFunc(req.URL)
+	}
+}
+
+// customCABundlePEM returns the PEM encoded certificates of a custom_ca_bundle
+// configured either inline or as a file path.
+func customCABundlePEM(v string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(v), "-----BEGIN") {
+		return []byte(v), nil
+	}
+
+	filename, err := homedir.Expand(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding custom_ca_bundle filename: %w", err)
+	}
+
+	pemCerts, err := ioutil.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading custom_ca_bundle (%s): %w", filename, err)
+	}
+
+	return pemCerts, nil
+}
+
+// sessionWithCredentials returns a session along with the account ID and
+// partition, resolving and validating credentials through the given HTTP client.
+// It mirrors awsbase.GetSessionWithAccountIDAndPartition, which always uses its
+// own HTTP client for those requests and only accepts static source credentials.
+// If creds is non-nil, they are used as the source credentials for any
+// assume_role, so both are refreshed as they expire. A nil httpClient uses
+// the AWS Go SDK default.
+func (c *Config) sessionWithCredentials(awsbaseConfig *awsbase.Config, httpClient *http.Client, creds *credentials.Credentials) (*session.Session, string, string, error) {
+	if creds == nil {
+		// As in awsbase.GetSession, skip_metadata_api_check removes the EC2
+		// metadata provider from the AWS Go SDK default chain.
+		if c.SkipMetadataApiCheck {
+			os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
 		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
+
+		// Static credentials, then the AWS Go SDK default chain: environment,
+		// shared credentials and configuration files and container or EC2 metadata.
+		options := session.Options{
+			Config: aws.Config{
+				EndpointResolver: awsbaseConfig.EndpointResolver(),
+				HTTPClient:       httpClient,
+				MaxRetries:       aws.Int(0),
+				Region:           aws.String(c.Region),
+			},
+			Profile:           c.Profile,
+			SharedConfigState: session.SharedConfigEnable,
 		}
-	})
 
-	client.organizationsconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
+		if c.AccessKey != "" {
+			options.Config.Credentials = credentials.NewStaticCredentials(c.AccessKey, c.SecretKey, c.Token)
 		}
-	})
 
-	client.storagegatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
+		if c.CredsFilename != "" {
+			filename, err := homedir.Expand(c.CredsFilename)
+
+			if err != nil {
+				return nil, "", "", fmt.Errorf("error expanding shared credentials filename: %w", err)
+			}
+
+			options.SharedConfigFiles = []string{filename}
 		}
-	})
 
-	client.wafv2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
+		sourceSess, err := session.NewSessionWithOptions(options)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error creating AWS session: %w", err)
 		}
 
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
+		creds = sourceSess.Config.Credentials
+	}
+
+	if c.AssumeRoleARN != "" {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID)
+
+		assumeRoleSess, err := session.NewSession(&aws.Config{
+			Credentials:      creds,
+			EndpointResolver: awsbaseConfig.EndpointResolver(),
+			HTTPClient:       httpClient,
+			MaxRetries:       aws.Int(c.MaxRetries),
+			Region:           aws.String(c.Region),
+		})
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error creating assume role session: %w", err)
 		}
 
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
+		creds = stscreds.NewCredentials(assumeRoleSess, c.AssumeRoleARN, func(p *stscreds.AssumeRoleProvider) {
+			if c.AssumeRoleDurationSeconds > 0 {
+				p.Duration = time.Duration(c.AssumeRoleDurationSeconds) * time.Second
 			}
-			if isAWSErr(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
+
+			if c.AssumeRoleExternalID != "" {
+				p.ExternalID = aws.String(c.AssumeRoleExternalID)
 			}
+
+			if c.AssumeRolePolicy != "" {
+				p.Policy = aws.String(c.AssumeRolePolicy)
+			}
+
+			for _, policyARN := range c.AssumeRolePolicyARNs {
+				p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+					Arn: aws.String(policyARN),
+				})
+			}
+
+			if c.AssumeRoleSessionName != "" {
+				p.RoleSessionName = c.AssumeRoleSessionName
+			}
+
+			for k, v := range c.AssumeRoleTags {
+				p.Tags = append(p.Tags, &sts.Tag{
+					Key:   aws.String(k),
+					Value: aws.String(v),
+				})
+			}
+
+			if len(c.AssumeRoleTransitiveTagKeys) > 0 {
+				p.TransitiveTagKeys = aws.StringSlice(c.AssumeRoleTransitiveTagKeys)
+			}
+		})
+	}
+
+	v, err := creds.Get()
+
+	if err != nil {
+		if c.AssumeRoleARN != "" {
+			return nil, "", "", awsbaseConfig.NewCannotAssumeRoleError(err)
 		}
+
+		return nil, "", "", awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	log.Printf("[INFO] AWS Auth provider used: %q", v.ProviderName)
+
+	staticConfig := *awsbaseConfig
+	staticConfig.AccessKey = v.AccessKeyID
+	staticConfig.AssumeRoleARN = ""
+	staticConfig.CredsFilename = ""
+	staticConfig.Profile = ""
+	staticConfig.SecretKey = v.SecretAccessKey
+	staticConfig.SkipCredsValidation = true
+	staticConfig.SkipRequestingAccountId = true
+	staticConfig.Token = v.SessionToken
+
+	sess, err := awsbase.GetSession(&staticConfig)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	sess = sess.Copy(&aws.Config{
+		Credentials: creds,
+		HTTPClient:  httpClient,
 	})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
+	if c.AssumeRoleARN != "" {
+		if roleARN, err := arn.Parse(c.AssumeRoleARN); err == nil {
+			return sess, roleARN.AccountID, roleARN.Partition, nil
+		}
+
+		return sess, "", "", nil
+	}
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(sess))
+
 		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
+			return nil, "", "", fmt.Errorf("error validating provider credentials: %w", err)
 		}
+
+		return sess, accountID, partition, nil
 	}
 
-	return client, nil
+	if !c.SkipRequestingAccountId {
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), sts.New(sess), v.ProviderName)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("AWS account ID not previously found and failed retrieving via all available methods. "+
+				"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+				"Errors: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	var partition string
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return sess, "", partition, nil
+}
+
+// webIdentityToken implements stscreds.TokenFetcher for a token configured inline.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(_ credentials.Context) ([]byte, error) {
+	return []byte(t), nil
 }
 
 func hasEc2Classic(platforms []string) bool {
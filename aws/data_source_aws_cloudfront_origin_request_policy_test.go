@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSCloudFrontDataSourceOriginRequestPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSource1Name := "data.aws_cloudfront_origin_request_policy.by_id"
+	dataSource2Name := "data.aws_cloudfront_origin_request_policy.by_name"
+	resourceName := "aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource1Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "cookies_config.#", resourceName, "cookies_config.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "cookies_config.0.cookie_behavior", resourceName, "cookies_config.0.cookie_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "headers_config.#", resourceName, "headers_config.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "headers_config.0.headers.0.items.#", resourceName, "headers_config.0.headers.0.items.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "query_strings_config.#", resourceName, "query_strings_config.#"),
+
+					resource.TestCheckResourceAttrPair(dataSource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "etag", resourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDataSourceOriginRequestPolicy_Managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "88a5eaf4-2fd4-4709-b370-b4c650ea3fcf"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CORS-S3Origin"),
+					resource.TestCheckResourceAttr(dataSourceName, "headers_config.0.header_behavior", "whitelist"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudfront_origin_request_policy" "by_name" {
+  name = aws_cloudfront_origin_request_policy.test.name
+}
+
+data "aws_cloudfront_origin_request_policy" "by_id" {
+  id = aws_cloudfront_origin_request_policy.test.id
+}
+
+resource "aws_cloudfront_origin_request_policy" "test" {
+  name    = %[1]q
+  comment = "test comment"
+
+  cookies_config {
+    cookie_behavior = "whitelist"
+
+    cookies {
+      items = ["test"]
+    }
+  }
+
+  headers_config {
+    header_behavior = "whitelist"
+
+    headers {
+      items = ["test"]
+    }
+  }
+
+  query_strings_config {
+    query_string_behavior = "whitelist"
+
+    query_strings {
+      items = ["test"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged() string {
+	return `
+data "aws_cloudfront_origin_request_policy" "test" {
+  name = "Managed-CORS-S3Origin"
+}
+`
+}
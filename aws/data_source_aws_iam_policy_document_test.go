@@ -146,6 +146,53 @@ func TestAccAWSDataSourceIAMPolicyDocument_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_sourceList(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourceListConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_source_list", "json",
+						testAccAWSIAMPolicyDocumentSourceListExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_sourceListConflicting(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentSourceListConflictingConfig,
+				ExpectError: regexp.MustCompile(`Found duplicate sid \(SourceJSONTestConflicting\) in source_policy_documents`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_overrideList(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentOverrideListConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_override_list", "json",
+						testAccAWSIAMPolicyDocumentOverrideListExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/10777
 func TestAccAWSDataSourceIAMPolicyDocument_Statement_Principal_Identifiers_StringAndSlice(t *testing.T) {
 	dataSourceName := "data.aws_iam_policy_document.test"
@@ -1022,3 +1069,178 @@ func testAccAWSIAMPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultipl
   ]
 }`, testAccGetPartition())
 }
+
+var testAccAWSIAMPolicyDocumentSourceListConfig = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid       = ""
+    effect    = "Allow"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "validSidOne"
+    effect    = "Allow"
+    actions   = ["bar:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid       = "validSidTwo"
+    effect    = "Deny"
+    actions   = ["foo:ActionTwo"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_c" {
+  statement {
+    sid       = ""
+    effect    = "Allow"
+    actions   = ["bar:ActionTwo"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_list" {
+  version = "2012-10-17"
+
+  source_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+    data.aws_iam_policy_document.policy_c.json,
+  ]
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourceListExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "foo:ActionOne",
+      "Resource": "*"
+    },
+    {
+      "Sid": "validSidOne",
+      "Effect": "Allow",
+      "Action": "bar:ActionOne",
+      "Resource": "*"
+    },
+    {
+      "Sid": "validSidTwo",
+      "Effect": "Deny",
+      "Action": "foo:ActionTwo",
+      "Resource": "*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "bar:ActionTwo",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentSourceListConflictingConfig = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid       = "SourceJSONTestConflicting"
+    effect    = "Allow"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid       = "SourceJSONTestConflicting"
+    effect    = "Deny"
+    actions   = ["foo:ActionTwo"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_list_conflicting" {
+  version = "2012-10-17"
+
+  source_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+  ]
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverrideListConfig = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid       = ""
+    effect    = "Allow"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "overrideSid"
+    effect    = "Allow"
+    actions   = ["bar:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid       = "validSidTwo"
+    effect    = "Deny"
+    actions   = ["foo:ActionTwo"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_c" {
+  statement {
+    sid       = "overrideSid"
+    effect    = "Deny"
+    actions   = ["bar:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_override_list" {
+  version = "2012-10-17"
+
+  override_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+    data.aws_iam_policy_document.policy_c.json,
+  ]
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverrideListExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "foo:ActionOne",
+      "Resource": "*"
+    },
+    {
+      "Sid": "overrideSid",
+      "Effect": "Deny",
+      "Action": "bar:ActionOne",
+      "Resource": "*"
+    },
+    {
+      "Sid": "validSidTwo",
+      "Effect": "Deny",
+      "Action": "foo:ActionTwo",
+      "Resource": "*"
+    }
+  ]
+}`
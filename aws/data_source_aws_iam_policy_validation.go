@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func dataSourceAwsIamPolicyValidation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamPolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ignore_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamPolicyValidationRead(d *schema.ResourceData, meta interface{}) error {
+	policy := d.Get("policy").(string)
+
+	doc, findings := iamPolicyParseDocument(policy)
+
+	if len(findings) > 0 && !d.Get("ignore_errors").(bool) {
+		messages := make([]string, len(findings))
+		for i, finding := range findings {
+			messages[i] = fmt.Sprintf("  * %s", finding)
+		}
+		return fmt.Errorf("IAM policy contains %d error(s):\n\n%s", len(findings), strings.Join(messages, "\n"))
+	}
+
+	errors := make([]string, len(findings))
+	for i, finding := range findings {
+		errors[i] = finding.Error()
+	}
+
+	var jsonString string
+	if doc != nil {
+		jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error normalizing IAM policy: %w", err)
+		}
+		jsonString = string(jsonDoc)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(policy)))
+	d.Set("json", jsonString)
+	d.Set("valid", len(findings) == 0)
+
+	if err := d.Set("errors", errors); err != nil {
+		return fmt.Errorf("error setting errors: %w", err)
+	}
+
+	return nil
+}
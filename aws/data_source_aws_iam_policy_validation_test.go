@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicyValidation_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyValidationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccAWSIAMPolicyValidationExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyValidation_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyValidationConfigInvalid,
+				ExpectError: regexp.MustCompile(`IAM policy contains 4 error\(s\)`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyValidation_ignoreErrors(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyValidationConfigIgnoreErrors,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0", `Statement[0]: unknown element "Resources", expected one of Action, Condition, Effect, NotAction, NotPrincipal, NotResource, Principal, Resource, Sid`),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.1", `Statement[0]: Effect must be one of Allow or Deny, got "Permit"`),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.2", `Statement[0]: Action "s3" must be * or of the form <service>:<action>`),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.3", `Statement[0].Condition: invalid condition operator "StringEqualz"`),
+					resource.TestCheckResourceAttr(dataSourceName, "json", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSIAMPolicyValidationConfig = `
+data "aws_iam_policy_validation" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = {
+      Effect   = "Allow"
+      Action   = ["s3:ListBucket", "s3:GetObject"]
+      Resource = ["*"]
+      Condition = {
+        Bool = {
+          "aws:SecureTransport" = true
+        }
+      }
+    }
+  })
+}
+`
+
+const testAccAWSIAMPolicyValidationExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListBucket",
+        "s3:GetObject"
+      ],
+      "Resource": "*",
+      "Condition": {
+        "Bool": {
+          "aws:SecureTransport": [
+            "true"
+          ]
+        }
+      }
+    }
+  ]
+}`
+
+const testAccAWSIAMPolicyValidationConfigInvalid = `
+data "aws_iam_policy_validation" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Permit"
+      Action    = "s3"
+      Resources = "*"
+      Condition = {
+        StringEqualz = {
+          "aws:username" = "example"
+        }
+      }
+    }]
+  })
+}
+`
+
+const testAccAWSIAMPolicyValidationConfigIgnoreErrors = `
+data "aws_iam_policy_validation" "test" {
+  ignore_errors = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Permit"
+      Action    = "s3"
+      Resources = "*"
+      Condition = {
+        StringEqualz = {
+          "aws:username" = "example"
+        }
+      }
+    }]
+  })
+}
+`
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func dataSourceAwsNetworkManagerDevice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNetworkManagerDeviceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_location": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latitude": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"longitude": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vendor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsNetworkManagerDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	deviceID := d.Get("device_id").(string)
+	device, err := finder.DeviceByGlobalNetworkIDAndDeviceID(conn, globalNetworkID, deviceID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Device (%s): %w", deviceID, err)
+	}
+
+	d.SetId(aws.StringValue(device.DeviceId))
+	d.Set("arn", device.DeviceArn)
+	if device.AWSLocation != nil {
+		if err := d.Set("aws_location", []interface{}{flattenNetworkManagerAWSLocation(device.AWSLocation)}); err != nil {
+			return fmt.Errorf("error setting aws_location: %w", err)
+		}
+	} else {
+		d.Set("aws_location", nil)
+	}
+	d.Set("description", device.Description)
+	d.Set("device_id", device.DeviceId)
+	d.Set("global_network_id", device.GlobalNetworkId)
+	if device.Location != nil {
+		if err := d.Set("location", []interface{}{flattenNetworkManagerLocation(device.Location)}); err != nil {
+			return fmt.Errorf("error setting location: %w", err)
+		}
+	} else {
+		d.Set("location", nil)
+	}
+	d.Set("model", device.Model)
+	d.Set("serial_number", device.SerialNumber)
+	d.Set("site_id", device.SiteId)
+	d.Set("type", device.Type)
+	d.Set("vendor", device.Vendor)
+
+	if err := d.Set("tags", keyvaluetags.NetworkmanagerKeyValueTags(device.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSNetworkManagerDevice_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_device.test"
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSNetworkManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSNetworkManagerDeviceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "device_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "model", resourceName, "model"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "serial_number", resourceName, "serial_number"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "site_id", resourceName, "site_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vendor", resourceName, "vendor"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSNetworkManagerDeviceConfig() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {
+  description = "test"
+
+  tags = {
+    Name = "test"
+  }
+}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  description       = "test"
+
+  location {
+    latitude  = "18.0029784"
+    longitude = "-76.7897987"
+  }
+
+  tags = {
+    Name = "test"
+  }
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  description       = "test"
+  model             = "test"
+  serial_number     = "test"
+  site_id           = aws_networkmanager_site.test.id
+  type              = "test"
+  vendor            = "test"
+
+  tags = {
+    Name = "test"
+  }
+}
+
+data "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  device_id         = aws_networkmanager_device.test.id
+}
+`
+}
@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSNetworkManagerGlobalNetwork_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_global_network.test"
+	resourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSNetworkManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSNetworkManagerGlobalNetworkConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSNetworkManagerGlobalNetworkConfig() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {
+  description = "test"
+
+  tags = {
+    Name = "test"
+  }
+}
+
+data "aws_networkmanager_global_network" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
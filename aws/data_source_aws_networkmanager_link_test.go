@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSNetworkManagerLink_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_link.test"
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSNetworkManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSNetworkManagerLinkConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bandwidth.#", resourceName, "bandwidth.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bandwidth.0.download_speed", resourceName, "bandwidth.0.download_speed"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bandwidth.0.upload_speed", resourceName, "bandwidth.0.upload_speed"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "link_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "provider_name", resourceName, "provider_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "site_id", resourceName, "site_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSNetworkManagerLinkConfig() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {
+  description = "test"
+
+  tags = {
+    Name = "test"
+  }
+}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  description       = "test"
+
+  location {
+    latitude  = "18.0029784"
+    longitude = "-76.7897987"
+  }
+
+  tags = {
+    Name = "test"
+  }
+}
+
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  description       = "test"
+  provider_name     = "test"
+  site_id           = aws_networkmanager_site.test.id
+  type              = "test"
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+
+  tags = {
+    Name = "test"
+  }
+}
+
+data "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  link_id           = aws_networkmanager_link.test.id
+}
+`
+}
@@ -1,9 +1,14 @@
 package aws
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
 type IAMPolicyDoc struct {
@@ -186,21 +191,31 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	var out IAMPolicyStatementConditionSet
 
 	var data map[string]map[string]interface{}
-	if err := json.Unmarshal(b, &data); err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
 		return err
 	}
 
 	for test_key, test_value := range data {
 		for var_key, var_values := range test_value {
 			switch var_values := var_values.(type) {
-			case string:
-				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					value, err := iamPolicyDecodeConditionValue(v)
+					if err != nil {
+						return err
+					}
+					values = append(values, value)
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
+			default:
+				value, err := iamPolicyDecodeConditionValue(var_values)
+				if err != nil {
+					return err
+				}
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{value}})
 			}
 		}
 	}
@@ -220,3 +235,378 @@ func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
 	return ret
 }
+
+// iamPolicyDecodeConditionValue converts a condition value, which IAM accepts
+// as a JSON string, boolean or number, to its string form.
+func iamPolicyDecodeConditionValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("Unsupported data type %T for IAMPolicyStatementCondition.Values", v)
+	}
+}
+
+var iamPolicyDocElements = []string{
+	"Id",
+	"Statement",
+	"Version",
+}
+
+var iamPolicyStatementElements = []string{
+	"Action",
+	"Condition",
+	"Effect",
+	"NotAction",
+	"NotPrincipal",
+	"NotResource",
+	"Principal",
+	"Resource",
+	"Sid",
+}
+
+var iamPolicyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+// iamPolicyParseDocument parses an IAM policy JSON document, checking its structure
+// against the IAM JSON policy grammar. It returns all structural findings, or,
+// when there are none, the document in its normalized form.
+func iamPolicyParseDocument(policy string) (*IAMPolicyDoc, []error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, []error{fmt.Errorf("policy is not a valid JSON object: %w", err)}
+	}
+
+	errs := iamPolicyValidateElements("", raw, iamPolicyDocElements)
+
+	if v, ok := raw["Version"]; ok {
+		var version string
+		if err := json.Unmarshal(v, &version); err != nil || (version != "2008-10-17" && version != "2012-10-17") {
+			errs = append(errs, fmt.Errorf("Version must be one of 2008-10-17 or 2012-10-17, got %s", v))
+		}
+	}
+
+	if v, ok := raw["Id"]; ok {
+		var id string
+		if err := json.Unmarshal(v, &id); err != nil {
+			errs = append(errs, fmt.Errorf("Id must be a string, got %s", v))
+		}
+	}
+
+	var statements []map[string]json.RawMessage
+
+	if v, ok := raw["Statement"]; !ok {
+		errs = append(errs, fmt.Errorf("missing required element Statement"))
+	} else if err := json.Unmarshal(v, &statements); err != nil {
+		// A single statement need not be wrapped in a list.
+		var statement map[string]json.RawMessage
+		if err := json.Unmarshal(v, &statement); err != nil {
+			errs = append(errs, fmt.Errorf("Statement must be an object or a list of objects, got %s", v))
+		} else {
+			statements = append(statements, statement)
+		}
+	}
+
+	for i, statement := range statements {
+		errs = append(errs, iamPolicyValidateStatement(fmt.Sprintf("Statement[%d]", i), statement)...)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	var err error
+	if raw["Statement"], err = json.Marshal(statements); err != nil {
+		return nil, []error{err}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, []error{err}
+	}
+
+	for _, statement := range doc.Statements {
+		statement.Actions = iamPolicyNormalizeStringList(statement.Actions)
+		statement.NotActions = iamPolicyNormalizeStringList(statement.NotActions)
+		statement.Resources = iamPolicyNormalizeStringList(statement.Resources)
+		statement.NotResources = iamPolicyNormalizeStringList(statement.NotResources)
+	}
+
+	return doc, nil
+}
+
+func iamPolicyValidateStatement(path string, statement map[string]json.RawMessage) []error {
+	errs := iamPolicyValidateElements(path, statement, iamPolicyStatementElements)
+
+	if v, ok := statement["Sid"]; ok {
+		var sid string
+		if err := json.Unmarshal(v, &sid); err != nil {
+			errs = append(errs, fmt.Errorf("%s: Sid must be a string, got %s", path, v))
+		}
+	}
+
+	if v, ok := statement["Effect"]; !ok {
+		errs = append(errs, fmt.Errorf("%s: missing required element Effect", path))
+	} else {
+		var effect string
+		if err := json.Unmarshal(v, &effect); err != nil || (effect != "Allow" && effect != "Deny") {
+			errs = append(errs, fmt.Errorf("%s: Effect must be one of Allow or Deny, got %s", path, v))
+		}
+	}
+
+	errs = append(errs, iamPolicyValidateExclusiveElements(path, statement, "Action", "NotAction", true)...)
+	errs = append(errs, iamPolicyValidateExclusiveElements(path, statement, "Resource", "NotResource", false)...)
+	errs = append(errs, iamPolicyValidateExclusiveElements(path, statement, "Principal", "NotPrincipal", false)...)
+
+	for _, k := range []string{"Action", "NotAction"} {
+		if v, ok := statement[k]; ok {
+			actions, ok := iamPolicyDecodeStringList(v)
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: %s must be a string or a list of strings, got %s", path, k, v))
+				continue
+			}
+			for _, action := range actions {
+				if action == "*" {
+					continue
+				}
+				if parts := strings.SplitN(action, ":", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					errs = append(errs, fmt.Errorf("%s: %s %q must be * or of the form <service>:<action>", path, k, action))
+				}
+			}
+		}
+	}
+
+	for _, k := range []string{"Resource", "NotResource"} {
+		if v, ok := statement[k]; ok {
+			resources, ok := iamPolicyDecodeStringList(v)
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: %s must be a string or a list of strings, got %s", path, k, v))
+				continue
+			}
+			for _, resource := range resources {
+				if resource == "*" {
+					continue
+				}
+				if _, err := arn.Parse(resource); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %s %q must be * or a valid ARN: %w", path, k, resource, err))
+				}
+			}
+		}
+	}
+
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if v, ok := statement[k]; ok {
+			errs = append(errs, iamPolicyValidatePrincipal(fmt.Sprintf("%s.%s", path, k), v)...)
+		}
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		errs = append(errs, iamPolicyValidateCondition(fmt.Sprintf("%s.Condition", path), v)...)
+	}
+
+	return errs
+}
+
+func iamPolicyValidatePrincipal(path string, v json.RawMessage) []error {
+	var wildcard string
+	if err := json.Unmarshal(v, &wildcard); err == nil {
+		if wildcard != "*" {
+			return []error{fmt.Errorf("%s must be * or an object, got %s", path, v)}
+		}
+		return nil
+	}
+
+	var principals map[string]json.RawMessage
+	if err := json.Unmarshal(v, &principals); err != nil {
+		return []error{fmt.Errorf("%s must be * or an object, got %s", path, v)}
+	}
+
+	var errs []error
+	for _, k := range iamPolicySortedKeys(principals) {
+		if _, ok := iamPolicyDecodeStringList(principals[k]); !ok {
+			errs = append(errs, fmt.Errorf("%s.%s must be a string or a list of strings, got %s", path, k, principals[k]))
+		}
+	}
+
+	return errs
+}
+
+func iamPolicyValidateCondition(path string, v json.RawMessage) []error {
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(v, &operators); err != nil {
+		return []error{fmt.Errorf("%s must be an object of condition operators, got %s", path, v)}
+	}
+
+	var errs []error
+	for _, operator := range iamPolicySortedKeys(operators) {
+		if !iamPolicyValidConditionOperator(operator) {
+			errs = append(errs, fmt.Errorf("%s: invalid condition operator %q", path, operator))
+		}
+
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(operators[operator], &keys); err != nil {
+			errs = append(errs, fmt.Errorf("%s.%s must be an object of condition keys, got %s", path, operator, operators[operator]))
+			continue
+		}
+
+		for _, key := range iamPolicySortedKeys(keys) {
+			var value interface{}
+			if err := json.Unmarshal(keys[key], &value); err != nil {
+				errs = append(errs, fmt.Errorf("%s.%s.%s: %w", path, operator, key, err))
+				continue
+			}
+
+			values, ok := value.([]interface{})
+			if !ok {
+				values = []interface{}{value}
+			}
+
+			for _, value := range values {
+				switch value.(type) {
+				case string, bool, float64:
+					continue
+				}
+
+				errs = append(errs, fmt.Errorf("%s.%s.%s must be a string, boolean, number or a list of these, got %s", path, operator, key, keys[key]))
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+// iamPolicyValidConditionOperator returns whether the condition operator is
+// valid, including any ForAllValues: or ForAnyValue: set operator prefix
+// and IfExists suffix. The Null operator does not support IfExists.
+func iamPolicyValidConditionOperator(operator string) bool {
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if strings.HasPrefix(operator, prefix) {
+			operator = strings.TrimPrefix(operator, prefix)
+			break
+		}
+	}
+
+	if strings.HasSuffix(operator, "IfExists") && operator != "NullIfExists" {
+		operator = strings.TrimSuffix(operator, "IfExists")
+	}
+
+	for _, valid := range iamPolicyConditionOperators {
+		if operator == valid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func iamPolicyValidateElements(path string, raw map[string]json.RawMessage, elements []string) []error {
+	var errs []error
+
+	for _, k := range iamPolicySortedKeys(raw) {
+		found := false
+		for _, element := range elements {
+			if k == element {
+				found = true
+				break
+			}
+		}
+		if !found {
+			err := fmt.Errorf("unknown element %q, expected one of %s", k, strings.Join(elements, ", "))
+			if path != "" {
+				err = fmt.Errorf("%s: %w", path, err)
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func iamPolicyValidateExclusiveElements(path string, raw map[string]json.RawMessage, element, notElement string, required bool) []error {
+	_, hasElement := raw[element]
+	_, hasNotElement := raw[notElement]
+
+	switch {
+	case hasElement && hasNotElement:
+		return []error{fmt.Errorf("%s: only one of %s or %s may be specified", path, element, notElement)}
+	case required && !hasElement && !hasNotElement:
+		return []error{fmt.Errorf("%s: one of %s or %s must be specified", path, element, notElement)}
+	}
+
+	return nil
+}
+
+// iamPolicyDecodeStringList decodes a JSON string or list of strings.
+func iamPolicyDecodeStringList(v json.RawMessage) ([]string, bool) {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return []string{s}, true
+	}
+
+	var l []string
+	if err := json.Unmarshal(v, &l); err == nil {
+		return l, true
+	}
+
+	return nil, false
+}
+
+// iamPolicyNormalizeStringList converts a decoded JSON string or list of
+// strings to the form produced by the aws_iam_policy_document data source.
+func iamPolicyNormalizeStringList(v interface{}) interface{} {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 {
+		return v
+	}
+
+	return iamPolicyDecodeConfigStringList(l)
+}
+
+func iamPolicySortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
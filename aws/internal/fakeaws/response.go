@@ -0,0 +1,125 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Protocol is an AWS API protocol, determining the format of error responses.
+type Protocol string
+
+const (
+	// ProtocolEC2 is the EC2 variant of the Query protocol.
+	ProtocolEC2 Protocol = "ec2"
+
+	// ProtocolJSON is the JSON protocol, e.g. CloudWatch Logs and DynamoDB.
+	ProtocolJSON Protocol = "json"
+
+	// ProtocolQuery is the Query protocol, e.g. IAM and SQS.
+	ProtocolQuery Protocol = "query"
+
+	// ProtocolRESTJSON is the REST-JSON protocol, e.g. Lambda and EKS.
+	ProtocolRESTJSON Protocol = "rest-json"
+
+	// ProtocolRESTXML is the REST-XML protocol, e.g. Route 53 and S3.
+	ProtocolRESTXML Protocol = "rest-xml"
+)
+
+// Response is a scripted response.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+// JSONResponse returns a successful JSON or REST-JSON protocol response.
+func JSONResponse(body string) Response {
+	return Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		Body:       body,
+	}
+}
+
+// XMLResponse returns a successful Query, EC2 or REST-XML protocol response.
+func XMLResponse(body string) Response {
+	return Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       body,
+	}
+}
+
+// ErrorResponse returns an error response in the format of the protocol.
+func ErrorResponse(protocol Protocol, statusCode int, code, message string) Response {
+	switch protocol {
+	case ProtocolJSON, ProtocolRESTJSON:
+		body, _ := json.Marshal(map[string]string{
+			"__type":  code,
+			"message": message,
+		})
+
+		return Response{
+			StatusCode: statusCode,
+			Header: http.Header{
+				"Content-Type":     []string{"application/x-amz-json-1.1"},
+				"X-Amzn-Errortype": []string{code},
+				"X-Amzn-Requestid": []string{requestID},
+			},
+			Body: string(body),
+		}
+	case ProtocolEC2:
+		return XMLErrorResponse(statusCode, fmt.Sprintf(`<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>%s</RequestID></Response>`, xmlEscape(code), xmlEscape(message), requestID))
+	default:
+		return XMLErrorResponse(statusCode, fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, xmlEscape(code), xmlEscape(message), requestID))
+	}
+}
+
+// XMLErrorResponse returns an error response with a raw XML body.
+func XMLErrorResponse(statusCode int, body string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       body,
+	}
+}
+
+// ThrottlingResponse returns an error response the AWS Go SDK retries as throttling.
+func ThrottlingResponse(protocol Protocol) Response {
+	switch protocol {
+	case ProtocolEC2:
+		return ErrorResponse(protocol, http.StatusServiceUnavailable, "RequestLimitExceeded", "Request limit exceeded.")
+	case ProtocolJSON, ProtocolRESTJSON:
+		return ErrorResponse(protocol, http.StatusBadRequest, "ThrottlingException", "Rate exceeded")
+	default:
+		return ErrorResponse(protocol, http.StatusBadRequest, "Throttling", "Rate exceeded")
+	}
+}
+
+const requestID = "01234567-89ab-cdef-0123-456789abcdef"
+
+func (r Response) write(w http.ResponseWriter) {
+	for k, v := range r.Header {
+		w.Header()[k] = v
+	}
+
+	statusCode := r.StatusCode
+
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.WriteHeader(statusCode)
+	w.Write([]byte(r.Body)) //nolint:errcheck
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
@@ -0,0 +1,191 @@
+// Package fakeaws provides an in-process fake AWS API endpoint for unit testing.
+//
+// A Server answers requests with responses scripted per API operation, so that
+// code using AWS Go SDK service clients can be exercised without AWS, including
+// throttling and eventual consistency errors.
+package fakeaws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Server is a fake AWS API endpoint.
+type Server struct {
+	*httptest.Server
+
+	t testing.TB
+
+	mu       sync.Mutex
+	handlers []*handler
+	requests []*Request
+}
+
+type handler struct {
+	operation string
+	responses []Response
+	served    int
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	// Operation is the AWS API operation name for JSON and Query protocol
+	// requests, or "METHOD /path" for REST protocol requests.
+	Operation string
+
+	Method string
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+// Form returns the parameters of a Query protocol request.
+func (r *Request) Form() url.Values {
+	values, _ := url.ParseQuery(string(r.Body))
+
+	return values
+}
+
+// NewServer starts a Server that is closed when the test completes.
+// Requests without a scripted response fail the test.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		t: t,
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Handle scripts responses for an operation. The operation is the AWS API
+// operation name for JSON and Query protocol services, e.g. DescribeLogGroups,
+// or the HTTP method and path prefix for REST protocol services,
+// e.g. "GET /2013-04-01/hostedzone/".
+//
+// Responses are returned in order for successive requests, with the last
+// response repeated once all have been returned. Scripting a sequence such as
+// a not found error followed by a success emulates eventual consistency.
+// Handlers are matched in the order they were added.
+func (s *Server) Handle(operation string, responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(responses) == 0 {
+		s.t.Fatalf("fakeaws: no responses scripted for %s", operation)
+	}
+
+	s.handlers = append(s.handlers, &handler{
+		operation: operation,
+		responses: responses,
+	})
+}
+
+// Requests returns the received requests for an operation, as given to Handle,
+// or all received requests for an empty operation.
+func (s *Server) Requests(operation string) []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []*Request
+
+	for _, r := range s.requests {
+		if operation == "" || r.matches(operation) {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		s.t.Errorf("fakeaws: error reading request body: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	request := &Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+	request.Operation = requestOperation(request)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, request)
+	response, ok := s.response(request)
+	s.mu.Unlock()
+
+	if !ok {
+		s.t.Errorf("fakeaws: no response scripted for request: %s %s (operation: %s)", r.Method, r.URL, request.Operation)
+		response = ErrorResponse(ProtocolJSON, http.StatusNotImplemented, "NotImplemented", "fakeaws: no response scripted")
+	}
+
+	response.write(w)
+}
+
+// response returns the next scripted response for a request.
+// The caller must hold the lock.
+func (s *Server) response(r *Request) (Response, bool) {
+	for _, h := range s.handlers {
+		if !r.matches(h.operation) {
+			continue
+		}
+
+		i := h.served
+
+		if i >= len(h.responses) {
+			i = len(h.responses) - 1
+		}
+
+		h.served++
+
+		return h.responses[i], true
+	}
+
+	return Response{}, false
+}
+
+func (r *Request) matches(operation string) bool {
+	if r.Operation == operation {
+		return true
+	}
+
+	// REST protocol operations match on method and path prefix.
+	parts := strings.SplitN(operation, " ", 2)
+
+	return len(parts) == 2 && r.Method == parts[0] && strings.HasPrefix(r.Path, parts[1])
+}
+
+// requestOperation returns the API operation name of a JSON or Query protocol
+// request, or "METHOD /path" otherwise.
+func requestOperation(r *Request) string {
+	// JSON protocol, e.g. X-Amz-Target: Logs_20140328.DescribeLogGroups
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		if i := strings.LastIndex(v, "."); i >= 0 {
+			return v[i+1:]
+		}
+
+		return v
+	}
+
+	// Query and EC2 protocols, e.g. Action=DescribeVpcs&Version=2016-11-15
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if v := r.Form().Get("Action"); v != "" {
+			return v
+		}
+	}
+
+	return fmt.Sprintf("%s %s", r.Method, r.Path)
+}
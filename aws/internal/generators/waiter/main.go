@@ -0,0 +1,612 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	statusOutputName = "status_gen.go"
+	waiterOutputName = "waiter_gen.go"
+)
+
+var (
+	specFile    = flag.String("spec", "waiters.json", "path to the waiter specification file")
+	packageName = flag.String("package", "", "override package name for generated code")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] <source-package>\n\n")
+	fmt.Fprintf(os.Stderr, "\tDestination package is read from the environment variable $GOPACKAGE by default. Override it with the flag -package.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	destinationPackage := os.Getenv("GOPACKAGE")
+	if *packageName != "" {
+		destinationPackage = *packageName
+	}
+	args := flag.Args()
+	if len(args) == 0 || destinationPackage == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	sourcePackage := args[0]
+
+	spec, err := readSpec(*specFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := Generator{}
+	g.parsePackage(sourcePackage)
+
+	headerInfo := HeaderInfo{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: destinationPackage,
+		SourcePackage:      sourcePackage,
+	}
+
+	var statusFuncs, waiterFuncs []*FuncSpec
+
+	for _, resource := range spec.Resources {
+		funcSpec := g.funcSpec(resource)
+
+		statusFuncs = append(statusFuncs, funcSpec)
+
+		if len(funcSpec.Waiters) > 0 {
+			waiterFuncs = append(waiterFuncs, funcSpec)
+		}
+	}
+
+	writeFile(statusOutputName, g.generate(statusTemplate, headerInfo, statusFuncs))
+	writeFile(waiterOutputName, g.generate(waiterTemplate, headerInfo, waiterFuncs))
+}
+
+// Spec is the waiter specification file, e.g.
+//
+//	{
+//	  "resources": [
+//	    {
+//	      "name": "CarrierGateway",
+//	      "function": "DescribeCarrierGateways",
+//	      "id_field": "CarrierGatewayIds",
+//	      "status_field": "State",
+//	      "not_found_error_codes": ["InvalidCarrierGatewayID.NotFound"],
+//	      "gone_statuses": ["ec2.CarrierGatewayStateDeleted"],
+//	      "waiters": [
+//	        {"name": "Available", "pending": ["ec2.CarrierGatewayStatePending"], "target": ["ec2.CarrierGatewayStateAvailable"], "timeout": "5m"},
+//	        {"name": "Deleted", "pending": ["ec2.CarrierGatewayStateDeleting"], "timeout": "5m"}
+//	      ]
+//	    }
+//	  ]
+//	}
+type Spec struct {
+	Resources []ResourceSpec `json:"resources"`
+}
+
+// ResourceSpec describes how to fetch the status of a resource.
+type ResourceSpec struct {
+	// Name of the resource, prefixing generated function names.
+	Name string `json:"name"`
+
+	// Function is the AWS Go SDK API function describing the resource.
+	Function string `json:"function"`
+
+	// IDField is the input field identifying the resource, either a string or a list of strings.
+	IDField string `json:"id_field"`
+
+	// OutputField is the output field containing the resource, either a structure or a list of
+	// structures of which the first is used. Defaults to the only structure field of the output.
+	OutputField string `json:"output_field"`
+
+	// StatusField is the string field of the resource containing its status.
+	StatusField string `json:"status_field"`
+
+	// NotFoundErrorCodes are API error codes returned when the resource does not exist.
+	NotFoundErrorCodes []string `json:"not_found_error_codes"`
+
+	// GoneStatuses are statuses of resources that no longer exist, such as deleted.
+	GoneStatuses []string `json:"gone_statuses"`
+
+	Waiters []WaiterSpec `json:"waiters"`
+}
+
+// WaiterSpec describes a resource.StateChangeConf.
+// Statuses are either AWS Go SDK constants, e.g. ec2.CarrierGatewayStatePending, or string values.
+type WaiterSpec struct {
+	// Name of the waiter, suffixing the resource name.
+	Name       string   `json:"name"`
+	Pending    []string `json:"pending"`
+	Target     []string `json:"target"`
+	Timeout    string   `json:"timeout"`
+	Delay      string   `json:"delay"`
+	MinTimeout string   `json:"min_timeout"`
+}
+
+func readSpec(filename string) (*Spec, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading spec: %w", err)
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("error parsing spec (%s): %w", filename, err)
+	}
+
+	return &spec, nil
+}
+
+type HeaderInfo struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+	UseTfawserr        bool
+}
+
+type Generator struct {
+	pkg *Package
+}
+
+type PackageFile struct {
+	file *ast.File
+}
+
+type Package struct {
+	name      string
+	files     []*PackageFile
+	constants map[string]bool
+}
+
+func (g *Generator) parsePackage(sourcePackage string) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+	g.addPackage(pkgs[0])
+}
+
+func (g *Generator) addPackage(pkg *packages.Package) {
+	g.pkg = &Package{
+		name:      pkg.Name,
+		files:     make([]*PackageFile, len(pkg.Syntax)),
+		constants: make(map[string]bool),
+	}
+
+	for i, file := range pkg.Syntax {
+		g.pkg.files[i] = &PackageFile{
+			file: file,
+		}
+
+		for _, decl := range file.Decls {
+			if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.CONST {
+				for _, spec := range genDecl.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						g.pkg.constants[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+type FuncSpec struct {
+	Name               string
+	LowerName          string
+	Function           string
+	RecvType           string
+	InputType          string
+	IDField            string
+	IDIsList           bool
+	OutputField        string
+	OutputIsList       bool
+	ResultType         string
+	StatusField        string
+	NotFoundErrorCodes []string
+	GoneStatuses       []string
+	Waiters            []*WaiterFuncSpec
+}
+
+type WaiterFuncSpec struct {
+	Name       string
+	Pending    []string
+	Target     []string
+	Timeout    string
+	Delay      string
+	MinTimeout string
+}
+
+func (g *Generator) funcSpec(resource ResourceSpec) *FuncSpec {
+	if resource.Name == "" || resource.Function == "" || resource.IDField == "" || resource.StatusField == "" {
+		log.Fatalf("resource %q: name, function, id_field and status_field are required", resource.Name)
+	}
+
+	function := g.findFunc(resource.Function)
+	if function == nil {
+		log.Fatalf("function %q not found", resource.Function)
+	}
+
+	inputType := g.findStruct(resource.Function + "Input")
+	outputType := g.findStruct(resource.Function + "Output")
+	if inputType == nil || outputType == nil {
+		log.Fatalf("function %q input or output type not found", resource.Function)
+	}
+
+	idType := fieldType(inputType, resource.IDField)
+	if idType == nil {
+		log.Fatalf("field %q not found in %sInput", resource.IDField, resource.Function)
+	}
+
+	outputField := resource.OutputField
+	if outputField == "" {
+		outputField = onlyStructField(outputType)
+	}
+	outputFieldType := fieldType(outputType, outputField)
+	if outputFieldType == nil {
+		log.Fatalf("field %q not found in %sOutput", outputField, resource.Function)
+	}
+
+	resultTypeName, outputIsList := elemTypeName(outputFieldType)
+	resultType := g.findStruct(resultTypeName)
+	if resultType == nil {
+		log.Fatalf("type %q not found", resultTypeName)
+	}
+	if fieldType(resultType, resource.StatusField) == nil {
+		log.Fatalf("field %q not found in %s", resource.StatusField, resultTypeName)
+	}
+
+	_, idIsList := idType.(*ast.ArrayType)
+
+	funcSpec := &FuncSpec{
+		Name:               resource.Name,
+		LowerName:          strings.ToLower(resource.Name[:1]) + resource.Name[1:],
+		Function:           resource.Function,
+		RecvType:           g.expandTypeField(function.Recv),
+		InputType:          fmt.Sprintf("%s.%sInput", g.pkg.name, resource.Function),
+		IDField:            resource.IDField,
+		IDIsList:           idIsList,
+		OutputField:        outputField,
+		OutputIsList:       outputIsList,
+		ResultType:         fmt.Sprintf("*%s.%s", g.pkg.name, resultTypeName),
+		StatusField:        resource.StatusField,
+		NotFoundErrorCodes: resource.NotFoundErrorCodes,
+		GoneStatuses:       g.statuses(resource.GoneStatuses),
+	}
+
+	for _, waiter := range resource.Waiters {
+		if waiter.Name == "" || waiter.Timeout == "" {
+			log.Fatalf("resource %q: waiter name and timeout are required", resource.Name)
+		}
+
+		funcSpec.Waiters = append(funcSpec.Waiters, &WaiterFuncSpec{
+			Name:       waiter.Name,
+			Pending:    g.statuses(waiter.Pending),
+			Target:     g.statuses(waiter.Target),
+			Timeout:    duration(waiter.Timeout),
+			Delay:      duration(waiter.Delay),
+			MinTimeout: duration(waiter.MinTimeout),
+		})
+	}
+
+	return funcSpec
+}
+
+func (g *Generator) findFunc(name string) *ast.FuncDecl {
+	for _, file := range g.pkg.files {
+		for _, decl := range file.file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv != nil && funcDecl.Name.Name == name {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) findStruct(name string) *ast.StructType {
+	for _, file := range g.pkg.files {
+		for _, decl := range file.file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if typeSpec.Name.Name != name {
+					continue
+				}
+
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					return structType
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func fieldType(structType *ast.StructType, name string) ast.Expr {
+	for _, field := range structType.Fields.List {
+		for _, fieldName := range field.Names {
+			if fieldName.Name == name {
+				return field.Type
+			}
+		}
+	}
+
+	return nil
+}
+
+// onlyStructField returns the name of the only exported structure or list of
+// structures field, as in most describe and get API outputs.
+func onlyStructField(structType *ast.StructType) string {
+	var names []string
+
+	for _, field := range structType.Fields.List {
+		if name, _ := elemTypeName(field.Type); name == "" {
+			continue
+		}
+
+		for _, name := range field.Names {
+			if ast.IsExported(name.Name) {
+				names = append(names, name.Name)
+			}
+		}
+	}
+
+	if len(names) != 1 {
+		log.Fatalf("output_field is required, found structure fields: %v", names)
+	}
+
+	return names[0]
+}
+
+// elemTypeName returns the type name of a *T or []*T field and whether it is a list.
+func elemTypeName(expr ast.Expr) (string, bool) {
+	isList := false
+
+	if array, ok := expr.(*ast.ArrayType); ok {
+		expr = array.Elt
+		isList = true
+	}
+
+	if star, ok := expr.(*ast.StarExpr); ok {
+		// Predeclared types such as string are not exported.
+		if ident, ok := star.X.(*ast.Ident); ok && ast.IsExported(ident.Name) {
+			return ident.Name, isList
+		}
+	}
+
+	return "", false
+}
+
+func (g *Generator) expandTypeField(field *ast.FieldList) string {
+	typeValue := field.List[0].Type
+	if star, ok := typeValue.(*ast.StarExpr); ok {
+		if ident, ok := star.X.(*ast.Ident); ok {
+			return fmt.Sprintf("*%s.%s", g.pkg.name, ident.Name)
+		}
+	}
+
+	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", typeValue)
+	return ""
+}
+
+var sdkConstantRegexp = regexp.MustCompile(`^([a-z0-9]+)\.([A-Z][A-Za-z0-9_]*)$`)
+
+// statuses returns Go expressions for statuses, either AWS Go SDK constants or quoted strings.
+func (g *Generator) statuses(values []string) []string {
+	result := make([]string, len(values))
+
+	for i, v := range values {
+		if matches := sdkConstantRegexp.FindStringSubmatch(v); matches != nil {
+			if matches[1] != g.pkg.name || !g.pkg.constants[matches[2]] {
+				log.Fatalf("constant %q not found in package %s", v, g.pkg.name)
+			}
+			result[i] = v
+			continue
+		}
+
+		result[i] = strconv.Quote(v)
+	}
+
+	return result
+}
+
+// duration returns a Go expression for a time.ParseDuration value.
+func duration(v string) string {
+	if v == "" {
+		return ""
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Fatalf("invalid duration %q: %s", v, err)
+	}
+
+	switch {
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+	}
+}
+
+func (g *Generator) generate(body string, headerInfo HeaderInfo, funcSpecs []*FuncSpec) []byte {
+	var buf bytes.Buffer
+
+	for _, funcSpec := range funcSpecs {
+		if len(funcSpec.NotFoundErrorCodes) > 0 {
+			headerInfo.UseTfawserr = true
+		}
+	}
+
+	tmpl := template.Must(template.New("file").Parse(body))
+
+	err := tmpl.Execute(&buf, struct {
+		HeaderInfo
+		Funcs []*FuncSpec
+	}{
+		HeaderInfo: headerInfo,
+		Funcs:      funcSpecs,
+	})
+	if err != nil {
+		log.Fatalf("error generating code: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		return buf.Bytes()
+	}
+	return src
+}
+
+func writeFile(filename string, src []byte) {
+	err := ioutil.WriteFile(filename, src, 0644)
+	if err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+const statusTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+{{- if .UseTfawserr }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+{{- end }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+{{ range $resource := .Funcs }}
+const (
+	{{ .LowerName }}{{ .StatusField }}NotFound = "NotFound"
+	{{ .LowerName }}{{ .StatusField }}Unknown  = "Unknown"
+)
+
+// {{ .Name }}{{ .StatusField }} fetches the {{ .Name }} and its {{ .StatusField }}
+func {{ .Name }}{{ .StatusField }}(conn {{ .RecvType }}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &{{ .InputType }}{
+{{- if .IDIsList }}
+			{{ .IDField }}: aws.StringSlice([]string{id}),
+{{- else }}
+			{{ .IDField }}: aws.String(id),
+{{- end }}
+		}
+
+		output, err := conn.{{ .Function }}(input)
+{{ if .NotFoundErrorCodes }}
+		if {{ range $i, $code := .NotFoundErrorCodes }}{{ if $i }} || {{ end }}tfawserr.ErrCodeEquals(err, "{{ $code }}"){{ end }} {
+			return nil, {{ .LowerName }}{{ .StatusField }}NotFound, nil
+		}
+{{ end }}
+		if err != nil {
+			return nil, {{ .LowerName }}{{ .StatusField }}Unknown, err
+		}
+{{ if .OutputIsList }}
+		if output == nil || len(output.{{ .OutputField }}) == 0 || output.{{ .OutputField }}[0] == nil {
+			return nil, {{ .LowerName }}{{ .StatusField }}NotFound, nil
+		}
+
+		result := output.{{ .OutputField }}[0]
+{{- else }}
+		if output == nil || output.{{ .OutputField }} == nil {
+			return nil, {{ .LowerName }}{{ .StatusField }}NotFound, nil
+		}
+
+		result := output.{{ .OutputField }}
+{{- end }}
+		status := aws.StringValue(result.{{ .StatusField }})
+{{ range .GoneStatuses }}
+		if status == {{ . }} {
+			return nil, {{ $resource.LowerName }}{{ $resource.StatusField }}NotFound, nil
+		}
+{{ end }}
+		return result, status, nil
+	}
+}
+{{ end }}`
+
+const waiterTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+{{ if .Funcs }}
+import (
+	"time"
+
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+{{ end }}
+{{- range $resource := .Funcs }}
+const (
+{{- range .Waiters }}
+	// Maximum amount of time to wait for a {{ $resource.Name }} to return {{ .Name }}
+	{{ $resource.Name }}{{ .Name }}Timeout = {{ .Timeout }}
+{{ end -}}
+)
+{{ range .Waiters }}
+// {{ $resource.Name }}{{ .Name }} waits for a {{ $resource.Name }} to return {{ .Name }}
+func {{ $resource.Name }}{{ .Name }}(conn {{ $resource.RecvType }}, id string) ({{ $resource.ResultType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- range $i, $v := .Pending }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} },
+		Target:  []string{ {{- range $i, $v := .Target }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} },
+		Refresh: {{ $resource.Name }}{{ $resource.StatusField }}(conn, id),
+		Timeout: {{ $resource.Name }}{{ .Name }}Timeout,
+{{- if .Delay }}
+		Delay:   {{ .Delay }},
+{{- end }}
+{{- if .MinTimeout }}
+		MinTimeout: {{ .MinTimeout }},
+{{- end }}
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.({{ $resource.ResultType }}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{ end }}
+{{- end }}`
@@ -0,0 +1,240 @@
+package httprecorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// EnvVarMode is the environment variable enabling the recorder.
+	// Valid values are ModeRecord and ModeReplay.
+	EnvVarMode = "TF_AWS_HTTP_RECORDER_MODE"
+
+	// EnvVarDir is the environment variable overriding the fixture directory.
+	EnvVarDir = "TF_AWS_HTTP_RECORDER_DIR"
+
+	// DefaultDir is the fixture directory, relative to the package under test,
+	// used when EnvVarDir is not set.
+	DefaultDir = "testdata/http-recordings"
+)
+
+// Mode is the operating mode of a Recorder.
+type Mode string
+
+const (
+	// ModeRecord sends requests to AWS and saves sanitized interactions.
+	ModeRecord Mode = "record"
+
+	// ModeReplay serves previously saved interactions without network access.
+	ModeReplay Mode = "replay"
+)
+
+// ModeFromEnv returns the Mode set in the EnvVarMode environment variable,
+// or an empty Mode if recording is not enabled.
+func ModeFromEnv() (Mode, error) {
+	switch v := Mode(os.Getenv(EnvVarMode)); v {
+	case "", ModeRecord, ModeReplay:
+		return v, nil
+	default:
+		return "", fmt.Errorf("invalid %s value (%s), expected %s or %s", EnvVarMode, v, ModeRecord, ModeReplay)
+	}
+}
+
+// DirFromEnv returns the fixture directory set in the EnvVarDir environment
+// variable, defaulting to DefaultDir.
+func DirFromEnv() string {
+	if v := os.Getenv(EnvVarDir); v != "" {
+		return v
+	}
+
+	return DefaultDir
+}
+
+// Recorder records interactions with AWS to a fixture file or replays them
+// from one, through the http.RoundTripper returned by Wrap. A Recorder can be
+// shared by multiple clients, such as the provider instances of each test step,
+// so that all interactions of a test are kept in order in one fixture.
+//
+// Recorded interactions are sanitized of credentials and request signatures
+// before being written. Replayed requests are matched against unused
+// interactions by method, URL and body, falling back to the next unused
+// interaction for the same method, URL and API operation so that requests
+// differing only in generated values such as idempotency tokens still match.
+type Recorder struct {
+	mu sync.Mutex
+
+	filename string
+	mode     Mode
+
+	interactions []*Interaction
+	used         []bool
+}
+
+// New returns a Recorder for the fixture file filename.
+// In ModeRecord, any existing fixture is overwritten on the first recorded
+// interaction. In ModeReplay, the fixture must exist.
+func New(mode Mode, filename string) (*Recorder, error) {
+	r := &Recorder{
+		filename: filename,
+		mode:     mode,
+	}
+
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		b, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading HTTP recorder fixture (%s): %w", filename, err)
+		}
+
+		var f fixture
+
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, fmt.Errorf("error parsing HTTP recorder fixture (%s): %w", filename, err)
+		}
+
+		r.interactions = f.Interactions
+		r.used = make([]bool, len(f.Interactions))
+	default:
+		return nil, fmt.Errorf("unsupported HTTP recorder mode: %s", mode)
+	}
+
+	return r, nil
+}
+
+// Mode returns the operating mode of the Recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Wrap returns an http.RoundTripper recording requests sent using transport,
+// or replaying them without using transport at all.
+// A nil transport uses http.DefaultTransport.
+func (r *Recorder) Wrap(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &roundTripper{
+		recorder:  r,
+		transport: transport,
+	}
+}
+
+type roundTripper struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := rt.recorder
+
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading HTTP request body: %w", err)
+		}
+
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	recordedRequest := newRecordedRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recordedRequest)
+	}
+
+	resp, err := rt.transport.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP response body: %w", err)
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interactions = append(r.interactions, &Interaction{
+		Request:  recordedRequest,
+		Response: newRecordedResponse(resp, respBody),
+	})
+
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recordedRequest *Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := r.find(recordedRequest.matchesExactly)
+
+	if i < 0 {
+		i = r.find(recordedRequest.matchesOperation)
+	}
+
+	if i < 0 {
+		return nil, fmt.Errorf("no unused HTTP recorder interaction in %s matches request: %s %s", r.filename, recordedRequest.Method, recordedRequest.URL)
+	}
+
+	r.used[i] = true
+
+	return r.interactions[i].Response.httpResponse(req)
+}
+
+// find returns the index of the first unused interaction satisfying match, or -1.
+// The caller must hold the lock.
+func (r *Recorder) find(match func(*Request) bool) int {
+	for i, interaction := range r.interactions {
+		if !r.used[i] && match(interaction.Request) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// save writes all recorded interactions to the fixture file.
+// The caller must hold the lock.
+func (r *Recorder) save() error {
+	b, err := json.MarshalIndent(&fixture{Interactions: r.interactions}, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding HTTP recorder fixture: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.filename), 0755); err != nil {
+		return fmt.Errorf("error creating HTTP recorder fixture directory: %w", err)
+	}
+
+	if err := ioutil.WriteFile(r.filename, b, 0644); err != nil {
+		return fmt.Errorf("error writing HTTP recorder fixture (%s): %w", r.filename, err)
+	}
+
+	return nil
+}
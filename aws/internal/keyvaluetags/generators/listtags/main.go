@@ -44,6 +44,7 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"connect",
 	"databasemigrationservice",
 	"dataexchange",
 	"datasync",
@@ -85,12 +86,14 @@ var serviceNames = []string{
 	"kms",
 	"lambda",
 	"licensemanager",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
 	"mediapackage",
 	"mediastore",
 	"mq",
+	"mwaa",
 	"neptune",
 	"networkfirewall",
 	"networkmanager",
@@ -102,6 +105,7 @@ var serviceNames = []string{
 	"rds",
 	"resourcegroups",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"sagemaker",
 	"securityhub",
@@ -114,6 +118,7 @@ var serviceNames = []string{
 	"ssoadmin",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -81,6 +81,7 @@ var sliceServiceNames = []string{
 	"redshift",
 	"resourcegroupstaggingapi",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"s3",
 	"s3control",
@@ -95,6 +96,7 @@ var sliceServiceNames = []string{
 	"ssoadmin",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -117,6 +119,7 @@ var mapServiceNames = []string{
 	"codestarnotifications",
 	"cognitoidentity",
 	"cognitoidentityprovider",
+	"connect",
 	"dataexchange",
 	"dlm",
 	"eks",
@@ -128,11 +131,13 @@ var mapServiceNames = []string{
 	"kinesisvideo",
 	"imagebuilder",
 	"lambda",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
 	"mediapackage",
 	"mq",
+	"mwaa",
 	"opsworks",
 	"qldb",
 	"pinpoint",
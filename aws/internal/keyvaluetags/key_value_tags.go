@@ -25,6 +25,22 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
+// MergeTags returns the result of keyvaluetags.Merge() on the given
+// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
@@ -62,6 +78,33 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	return result
 }
 
+// IgnoreDefaultConfig returns tags not present in, or with values that
+// differ from, the given DefaultConfig. Keys present in resourceTags are
+// always kept, so a tag configured both on the resource and in the
+// DefaultConfig with the same value is not removed.
+// This is used to derive a resource's own tags from the full set of tags
+// applied to it (i.e. tags_all).
+func (tags KeyValueTags) IgnoreDefaultConfig(config *DefaultConfig, resourceTags KeyValueTags) KeyValueTags {
+	if config == nil || config.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := resourceTags[k]; ok {
+			result[k] = v
+			continue
+		}
+
+		if defaultV, ok := config.Tags[k]; !ok || !defaultV.Equal(v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
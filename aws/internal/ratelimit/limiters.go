@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"sync"
+)
+
+// Limiters is a set of token bucket rate limiters keyed by service.
+type Limiters struct {
+	mu       sync.Mutex
+	adaptive bool
+	buckets  map[string]*TokenBucket
+}
+
+// NewLimiters returns an empty set of rate limiters.
+// When adaptive, every service is given an adaptive TokenBucket,
+// otherwise only services added with Add are limited.
+func NewLimiters(adaptive bool) *Limiters {
+	return &Limiters{
+		adaptive: adaptive,
+		buckets:  make(map[string]*TokenBucket),
+	}
+}
+
+// Add limits a service to rate requests per second with the given burst.
+func (l *Limiters) Add(service string, rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.adaptive {
+		l.buckets[service] = NewAdaptiveTokenBucket(rate, burst)
+	} else {
+		l.buckets[service] = NewTokenBucket(rate, burst)
+	}
+}
+
+// Get returns the TokenBucket for a service.
+// Returns nil if the service is not limited.
+func (l *Limiters) Get(service string) *TokenBucket {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[service]
+
+	if !ok && l.adaptive {
+		b = NewAdaptiveTokenBucket(0, 1)
+		l.buckets[service] = b
+	}
+
+	return b
+}
@@ -0,0 +1,206 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// adaptiveMinRate is the lowest fill rate (requests per second)
+	// an adaptive token bucket will back off to.
+	adaptiveMinRate = 0.5
+
+	// adaptiveBackoffFactor is the multiplicative decrease applied to
+	// the fill rate of an adaptive token bucket when throttled.
+	adaptiveBackoffFactor = 0.7
+)
+
+// TokenBucket is a client-side token bucket rate limiter.
+//
+// Tokens are added at Rate per second up to Burst. Each request consumes a
+// single token, waiting for one to become available if necessary.
+// An adaptive TokenBucket additionally decreases its fill rate when the
+// remote service throttles requests and slowly recovers on success.
+type TokenBucket struct {
+	mu sync.Mutex
+
+	adaptive bool
+	burst    float64
+	maxRate  float64
+	rate     float64
+	tokens   float64
+	last     time.Time
+
+	// Sent request measurement, used by adaptive buckets to find
+	// a starting fill rate when first throttled.
+	measuredRate float64
+	windowCount  int
+	windowStart  time.Time
+
+	now func() time.Time
+}
+
+// NewTokenBucket returns a TokenBucket filling at rate tokens per second
+// with capacity burst. A zero rate does not limit requests.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		burst:   float64(burst),
+		maxRate: rate,
+		rate:    rate,
+		tokens:  float64(burst),
+		now:     time.Now,
+	}
+}
+
+// NewAdaptiveTokenBucket returns a TokenBucket that starts filling at rate
+// tokens per second (zero being unlimited) and adapts to throttling responses,
+// never exceeding the initial rate if one is given.
+func NewAdaptiveTokenBucket(rate float64, burst int) *TokenBucket {
+	b := NewTokenBucket(rate, burst)
+	b.adaptive = true
+
+	return b
+}
+
+// Rate returns the current fill rate in tokens per second.
+// Zero means requests are not limited.
+func (b *TokenBucket) Rate() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.rate
+}
+
+// Wait blocks until a token is available or the context is done.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	b.measure(b.now())
+	b.mu.Unlock()
+
+	for {
+		b.mu.Lock()
+		now := b.now()
+
+		if b.rate <= 0 {
+			b.mu.Unlock()
+			return nil
+		}
+
+		b.refill(now)
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Throttled records that the remote service throttled a request.
+// Adaptive buckets decrease their fill rate.
+func (b *TokenBucket) Throttled() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	rate := b.rate
+
+	if rate <= 0 {
+		// Previously unlimited: start from the observed request rate.
+		rate = b.measuredRate
+		b.last = now
+		b.tokens = 0
+	} else {
+		b.refill(now)
+	}
+
+	rate *= adaptiveBackoffFactor
+
+	if rate < adaptiveMinRate {
+		rate = adaptiveMinRate
+	}
+
+	b.rate = rate
+}
+
+// Succeeded records that a request succeeded.
+// Adaptive buckets slowly increase their fill rate, roughly one request per
+// second per second, back towards their maximum.
+func (b *TokenBucket) Succeeded() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate <= 0 {
+		return
+	}
+
+	b.refill(b.now())
+	b.rate += 1 / b.rate
+
+	switch {
+	case b.maxRate > 0 && b.rate > b.maxRate:
+		b.rate = b.maxRate
+	case b.maxRate <= 0 && b.measuredRate > 0 && b.rate > 2*b.measuredRate:
+		// Comfortably above demand: stop limiting.
+		b.rate = 0
+	}
+}
+
+// refill adds tokens accrued since the last refill.
+// The caller must hold the lock.
+func (b *TokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+	}
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	b.last = now
+}
+
+// measure counts a request towards the measured request rate.
+// The caller must hold the lock.
+func (b *TokenBucket) measure(now time.Time) {
+	if b.windowStart.IsZero() {
+		b.windowStart = now
+	}
+
+	if elapsed := now.Sub(b.windowStart); elapsed >= time.Second {
+		b.measuredRate = float64(b.windowCount) / elapsed.Seconds()
+		b.windowCount = 0
+		b.windowStart = now
+	}
+
+	b.windowCount++
+
+	if b.measuredRate == 0 {
+		b.measuredRate = float64(b.windowCount)
+	}
+}
@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func testTokenBucket(b *TokenBucket) (*TokenBucket, *testClock) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	b.now = clock.Now
+	b.last = clock.now
+
+	return b, clock
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	b, clock := testTokenBucket(NewTokenBucket(2, 2))
+
+	for i := 0; i < 2; i++ {
+		if err := b.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error waiting for burst token %d: %s", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.Wait(ctx); err == nil {
+		t.Fatal("expected error waiting with canceled context and empty bucket, got none")
+	}
+
+	clock.Advance(500 * time.Millisecond)
+
+	if err := b.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error waiting for refilled token: %s", err)
+	}
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	b, _ := testTokenBucket(NewTokenBucket(0, 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 100; i++ {
+		if err := b.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error waiting on unlimited bucket: %s", err)
+		}
+	}
+}
+
+func TestTokenBucketNotAdaptive(t *testing.T) {
+	b, _ := testTokenBucket(NewTokenBucket(10, 1))
+
+	b.Throttled()
+
+	if got, want := b.Rate(), 10.0; got != want {
+		t.Errorf("got rate %f after throttling, want %f", got, want)
+	}
+}
+
+func TestTokenBucketAdaptive(t *testing.T) {
+	b, _ := testTokenBucket(NewAdaptiveTokenBucket(10, 1))
+
+	b.Throttled()
+
+	if got, want := b.Rate(), 7.0; got != want {
+		t.Errorf("got rate %f after throttling, want %f", got, want)
+	}
+
+	for i := 0; i < 100; i++ {
+		b.Succeeded()
+	}
+
+	if got, want := b.Rate(), 10.0; got != want {
+		t.Errorf("got rate %f after recovering, want %f", got, want)
+	}
+
+	for i := 0; i < 100; i++ {
+		b.Throttled()
+	}
+
+	if got, want := b.Rate(), adaptiveMinRate; got != want {
+		t.Errorf("got rate %f after repeated throttling, want %f", got, want)
+	}
+}
+
+func TestTokenBucketAdaptiveUnlimited(t *testing.T) {
+	b, clock := testTokenBucket(NewAdaptiveTokenBucket(0, 1))
+
+	for i := 0; i < 20; i++ {
+		if err := b.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error waiting on unlimited bucket: %s", err)
+		}
+
+		clock.Advance(100 * time.Millisecond)
+	}
+
+	b.Throttled()
+
+	if got, want := b.Rate(), 7.0; got != want {
+		t.Errorf("got rate %f after throttling, want %f", got, want)
+	}
+}
+
+func TestLimiters(t *testing.T) {
+	l := NewLimiters(false)
+	l.Add("ec2", 5, 1)
+
+	if b := l.Get("ec2"); b == nil || b.Rate() != 5 {
+		t.Errorf("expected ec2 limiter with rate 5, got %v", b)
+	}
+
+	if b := l.Get("s3"); b != nil {
+		t.Errorf("expected no s3 limiter, got %v", b)
+	}
+
+	l = NewLimiters(true)
+
+	if b := l.Get("s3"); b == nil || b.Rate() != 0 {
+		t.Errorf("expected unlimited adaptive s3 limiter, got %v", b)
+	}
+
+	if l.Get("s3") != l.Get("s3") {
+		t.Error("expected the same s3 limiter on repeated calls")
+	}
+}
@@ -0,0 +1,65 @@
+package amplify
+
+import (
+	"testing"
+)
+
+func TestBranchParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		InputID            string
+		ExpectedError      bool
+		ExpectedAppID      string
+		ExpectedBranchName string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing branch name",
+			InputID:       "d2ypk4k47z8u6/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing app ID",
+			InputID:       "/main",
+			ExpectedError: true,
+		},
+		{
+			TestName:           "simple branch name",
+			InputID:            "d2ypk4k47z8u6/main",
+			ExpectedAppID:      "d2ypk4k47z8u6",
+			ExpectedBranchName: "main",
+		},
+		{
+			TestName:           "branch name containing separator",
+			InputID:            "d2ypk4k47z8u6/feature/example",
+			ExpectedAppID:      "d2ypk4k47z8u6",
+			ExpectedBranchName: "feature/example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAppID, gotBranchName, err := BranchParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotAppID != testCase.ExpectedAppID {
+				t.Errorf("got app ID %s, expected %s", gotAppID, testCase.ExpectedAppID)
+			}
+
+			if gotBranchName != testCase.ExpectedBranchName {
+				t.Errorf("got branch name %s, expected %s", gotBranchName, testCase.ExpectedBranchName)
+			}
+		})
+	}
+}
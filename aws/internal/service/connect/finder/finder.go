@@ -0,0 +1,69 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// InstanceByID returns the Instance corresponding to the specified ID.
+// Returns NotFoundError if no Instance is found.
+func InstanceByID(conn *connect.Connect, id string) (*connect.Instance, error) {
+	input := &connect.DescribeInstanceInput{
+		InstanceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInstance(input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Instance == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Instance, nil
+}
+
+// ContactFlowByInstanceIDAndContactFlowID returns the Contact Flow corresponding to the specified Instance ID and Contact Flow ID.
+// Returns NotFoundError if no Contact Flow is found.
+func ContactFlowByInstanceIDAndContactFlowID(conn *connect.Connect, instanceID, contactFlowID string) (*connect.ContactFlow, error) {
+	input := &connect.DescribeContactFlowInput{
+		ContactFlowId: aws.String(contactFlowID),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeContactFlow(input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactFlow == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ContactFlow, nil
+}
@@ -0,0 +1,25 @@
+package connect
+
+import (
+	"fmt"
+	"strings"
+)
+
+const contactFlowResourceIDSeparator = ":"
+
+func ContactFlowCreateResourceID(instanceID, contactFlowID string) string {
+	parts := []string{instanceID, contactFlowID}
+	id := strings.Join(parts, contactFlowResourceIDSeparator)
+
+	return id
+}
+
+func ContactFlowParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, contactFlowResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INSTANCEID%[2]sCONTACTFLOWID", id, contactFlowResourceIDSeparator)
+}
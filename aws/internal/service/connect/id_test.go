@@ -0,0 +1,66 @@
+package connect_test
+
+import (
+	"testing"
+
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+)
+
+func TestContactFlowParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		InputID               string
+		ExpectedError         bool
+		ExpectedInstanceID    string
+		ExpectedContactFlowID string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "incorrect format",
+			InputID:       "test",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing contact flow ID",
+			InputID:       "instance-id:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "instance-id:contact-flow-id:extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:              "valid ID",
+			InputID:               tfconnect.ContactFlowCreateResourceID("instance-id", "contact-flow-id"),
+			ExpectedInstanceID:    "instance-id",
+			ExpectedContactFlowID: "contact-flow-id",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotInstanceID, gotContactFlowID, err := tfconnect.ContactFlowParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotInstanceID != testCase.ExpectedInstanceID {
+				t.Errorf("got instance ID %s, expected %s", gotInstanceID, testCase.ExpectedInstanceID)
+			}
+
+			if gotContactFlowID != testCase.ExpectedContactFlowID {
+				t.Errorf("got contact flow ID %s, expected %s", gotContactFlowID, testCase.ExpectedContactFlowID)
+			}
+		})
+	}
+}
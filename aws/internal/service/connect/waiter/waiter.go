@@ -0,0 +1,58 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Instance to be created
+	InstanceCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an Instance to be deleted
+	InstanceDeletedTimeout = 5 * time.Minute
+)
+
+// InstanceCreated waits for an Instance to be created
+func InstanceCreated(conn *connect.Connect, instanceID string) (*connect.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connect.InstanceStatusCreationInProgress},
+		Target:  []string{connect.InstanceStatusActive},
+		Refresh: InstanceStatus(conn, instanceID),
+		Timeout: InstanceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*connect.Instance); ok {
+		if err != nil && aws.StringValue(output.InstanceStatus) == connect.InstanceStatusCreationFailed && output.StatusReason != nil {
+			return output, fmt.Errorf("%w: %s", err, aws.StringValue(output.StatusReason.Message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InstanceDeleted waits for an Instance to be deleted
+func InstanceDeleted(conn *connect.Connect, instanceID string) (*connect.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connect.InstanceStatusActive},
+		Target:  []string{},
+		Refresh: InstanceStatus(conn, instanceID),
+		Timeout: InstanceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*connect.Instance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
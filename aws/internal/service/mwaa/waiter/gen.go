@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/waiter/main.go github.com/aws/aws-sdk-go/service/mwaa
+
+package waiter
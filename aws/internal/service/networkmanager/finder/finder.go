@@ -0,0 +1,300 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// GlobalNetworkByID returns the Global Network corresponding to the specified ID.
+// Returns NotFoundError if no Global Network is found.
+func GlobalNetworkByID(conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: aws.StringSlice([]string{id}),
+	}
+
+	var result *networkmanager.GlobalNetwork
+
+	err := conn.DescribeGlobalNetworksPages(input, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, globalNetwork := range page.GlobalNetworks {
+			if aws.StringValue(globalNetwork.GlobalNetworkId) == id {
+				result = globalNetwork
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// SiteByGlobalNetworkIDAndSiteID returns the Site corresponding to the specified Global Network ID and Site ID.
+// Returns NotFoundError if no Site is found.
+func SiteByGlobalNetworkIDAndSiteID(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteIds:         aws.StringSlice([]string{siteID}),
+	}
+
+	var result *networkmanager.Site
+
+	err := conn.GetSitesPages(input, func(page *networkmanager.GetSitesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, site := range page.Sites {
+			if aws.StringValue(site.SiteId) == siteID {
+				result = site
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// DeviceByGlobalNetworkIDAndDeviceID returns the Device corresponding to the specified Global Network ID and Device ID.
+// Returns NotFoundError if no Device is found.
+func DeviceByGlobalNetworkIDAndDeviceID(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	input := &networkmanager.GetDevicesInput{
+		DeviceIds:       aws.StringSlice([]string{deviceID}),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	var result *networkmanager.Device
+
+	err := conn.GetDevicesPages(input, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, device := range page.Devices {
+			if aws.StringValue(device.DeviceId) == deviceID {
+				result = device
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// LinkByGlobalNetworkIDAndLinkID returns the Link corresponding to the specified Global Network ID and Link ID.
+// Returns NotFoundError if no Link is found.
+func LinkByGlobalNetworkIDAndLinkID(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	input := &networkmanager.GetLinksInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkIds:         aws.StringSlice([]string{linkID}),
+	}
+
+	var result *networkmanager.Link
+
+	err := conn.GetLinksPages(input, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, link := range page.Links {
+			if aws.StringValue(link.LinkId) == linkID {
+				result = link
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// LinkAssociationByGlobalNetworkIDLinkIDAndDeviceID returns the Link Association corresponding to the specified Global Network ID, Link ID and Device ID.
+// Returns NotFoundError if no Link Association is found or it has been deleted.
+func LinkAssociationByGlobalNetworkIDLinkIDAndDeviceID(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	input := &networkmanager.GetLinkAssociationsInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	var result *networkmanager.LinkAssociation
+
+	err := conn.GetLinkAssociationsPages(input, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, linkAssociation := range page.LinkAssociations {
+			if aws.StringValue(linkAssociation.LinkId) == linkID && aws.StringValue(linkAssociation.DeviceId) == deviceID {
+				result = linkAssociation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if state := aws.StringValue(result.LinkAssociationState); state == networkmanager.LinkAssociationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// TransitGatewayRegistrationByGlobalNetworkIDAndTransitGatewayARN returns the Transit Gateway Registration corresponding to the specified Global Network ID and Transit Gateway ARN.
+// Returns NotFoundError if no Transit Gateway Registration is found or it has been deleted.
+func TransitGatewayRegistrationByGlobalNetworkIDAndTransitGatewayARN(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	input := &networkmanager.GetTransitGatewayRegistrationsInput{
+		GlobalNetworkId:    aws.String(globalNetworkID),
+		TransitGatewayArns: aws.StringSlice([]string{transitGatewayARN}),
+	}
+
+	var result *networkmanager.TransitGatewayRegistration
+
+	err := conn.GetTransitGatewayRegistrationsPages(input, func(page *networkmanager.GetTransitGatewayRegistrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, transitGatewayRegistration := range page.TransitGatewayRegistrations {
+			if aws.StringValue(transitGatewayRegistration.TransitGatewayArn) == transitGatewayARN {
+				result = transitGatewayRegistration
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if result.State != nil {
+		if state := aws.StringValue(result.State.Code); state == networkmanager.TransitGatewayRegistrationStateDeleted {
+			return nil, &resource.NotFoundError{
+				Message:     state,
+				LastRequest: input,
+			}
+		}
+	}
+
+	return result, nil
+}
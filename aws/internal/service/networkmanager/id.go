@@ -0,0 +1,65 @@
+package networkmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const linkAssociationResourceIDSeparator = ","
+
+func LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID string) string {
+	parts := []string{globalNetworkID, linkID, deviceID}
+	id := strings.Join(parts, linkAssociationResourceIDSeparator)
+
+	return id
+}
+
+func LinkAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, linkAssociationResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBALNETWORKID%[2]sLINKID%[2]sDEVICEID", id, linkAssociationResourceIDSeparator)
+}
+
+const transitGatewayRegistrationResourceIDSeparator = ","
+
+func TransitGatewayRegistrationCreateResourceID(globalNetworkID, transitGatewayARN string) string {
+	parts := []string{globalNetworkID, transitGatewayARN}
+	id := strings.Join(parts, transitGatewayRegistrationResourceIDSeparator)
+
+	return id
+}
+
+func TransitGatewayRegistrationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayRegistrationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBALNETWORKID%[2]sTRANSITGATEWAYARN", id, transitGatewayRegistrationResourceIDSeparator)
+}
+
+// GlobalNetworkResourceParseARN splits the ARN of a resource that is scoped to a global network,
+// e.g. arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223,
+// into the global network ID and the resource's own ID.
+func GlobalNetworkResourceParseARN(resourceARN, resourceType string) (string, string, error) {
+	parsedARN, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", resourceARN, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) == 3 && parts[0] == resourceType && parts[1] != "" && parts[2] != "" {
+		return parts[1], parts[2], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ARN resource (%[1]s), expected %[2]s/GLOBALNETWORKID/%[3]sID", parsedARN.Resource, resourceType, strings.ToUpper(resourceType))
+}
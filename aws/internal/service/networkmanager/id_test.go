@@ -0,0 +1,173 @@
+package networkmanager
+
+import (
+	"testing"
+)
+
+func TestLinkAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputID                 string
+		ExpectedError           bool
+		ExpectedGlobalNetworkID string
+		ExpectedLinkID          string
+		ExpectedDeviceID        string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing device ID",
+			InputID:       "global-network-01231231231231231,link-11112222aaaabbbb1",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty part",
+			InputID:       "global-network-01231231231231231,,device-07f6fd08867abc123",
+			ExpectedError: true,
+		},
+		{
+			TestName:                "valid ID",
+			InputID:                 "global-network-01231231231231231,link-11112222aaaabbbb1,device-07f6fd08867abc123",
+			ExpectedGlobalNetworkID: "global-network-01231231231231231",
+			ExpectedLinkID:          "link-11112222aaaabbbb1",
+			ExpectedDeviceID:        "device-07f6fd08867abc123",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGlobalNetworkID, gotLinkID, gotDeviceID, err := LinkAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotGlobalNetworkID != testCase.ExpectedGlobalNetworkID {
+				t.Errorf("got global network ID %s, expected %s", gotGlobalNetworkID, testCase.ExpectedGlobalNetworkID)
+			}
+
+			if gotLinkID != testCase.ExpectedLinkID {
+				t.Errorf("got link ID %s, expected %s", gotLinkID, testCase.ExpectedLinkID)
+			}
+
+			if gotDeviceID != testCase.ExpectedDeviceID {
+				t.Errorf("got device ID %s, expected %s", gotDeviceID, testCase.ExpectedDeviceID)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRegistrationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                  string
+		InputID                   string
+		ExpectedError             bool
+		ExpectedGlobalNetworkID   string
+		ExpectedTransitGatewayARN string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing transit gateway ARN",
+			InputID:       "global-network-01231231231231231,",
+			ExpectedError: true,
+		},
+		{
+			TestName:                  "valid ID",
+			InputID:                   "global-network-01231231231231231,arn:aws:ec2:us-west-2:123456789012:transit-gateway/tgw-123abc05e04123abc",
+			ExpectedGlobalNetworkID:   "global-network-01231231231231231",
+			ExpectedTransitGatewayARN: "arn:aws:ec2:us-west-2:123456789012:transit-gateway/tgw-123abc05e04123abc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGlobalNetworkID, gotTransitGatewayARN, err := TransitGatewayRegistrationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotGlobalNetworkID != testCase.ExpectedGlobalNetworkID {
+				t.Errorf("got global network ID %s, expected %s", gotGlobalNetworkID, testCase.ExpectedGlobalNetworkID)
+			}
+
+			if gotTransitGatewayARN != testCase.ExpectedTransitGatewayARN {
+				t.Errorf("got transit gateway ARN %s, expected %s", gotTransitGatewayARN, testCase.ExpectedTransitGatewayARN)
+			}
+		})
+	}
+}
+
+func TestGlobalNetworkResourceParseARN(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputARN                string
+		InputResourceType       string
+		ExpectedError           bool
+		ExpectedGlobalNetworkID string
+		ExpectedResourceID      string
+	}{
+		{
+			TestName:          "empty ARN",
+			InputARN:          "",
+			InputResourceType: "site",
+			ExpectedError:     true,
+		},
+		{
+			TestName:          "unexpected resource type",
+			InputARN:          "arn:aws:networkmanager::123456789012:device/global-network-01231231231231231/device-07f6fd08867abc123",
+			InputResourceType: "site",
+			ExpectedError:     true,
+		},
+		{
+			TestName:          "missing resource ID",
+			InputARN:          "arn:aws:networkmanager::123456789012:site/global-network-01231231231231231",
+			InputResourceType: "site",
+			ExpectedError:     true,
+		},
+		{
+			TestName:                "valid site ARN",
+			InputARN:                "arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223",
+			InputResourceType:       "site",
+			ExpectedGlobalNetworkID: "global-network-01231231231231231",
+			ExpectedResourceID:      "site-444555aaabbb11223",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGlobalNetworkID, gotResourceID, err := GlobalNetworkResourceParseARN(testCase.InputARN, testCase.InputResourceType)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotGlobalNetworkID != testCase.ExpectedGlobalNetworkID {
+				t.Errorf("got global network ID %s, expected %s", gotGlobalNetworkID, testCase.ExpectedGlobalNetworkID)
+			}
+
+			if gotResourceID != testCase.ExpectedResourceID {
+				t.Errorf("got resource ID %s, expected %s", gotResourceID, testCase.ExpectedResourceID)
+			}
+		})
+	}
+}
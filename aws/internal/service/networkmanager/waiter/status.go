@@ -0,0 +1,115 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// GlobalNetworkStatus fetches the Global Network and its State
+func GlobalNetworkStatus(conn *networkmanager.NetworkManager, globalNetworkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalNetwork, err := finder.GlobalNetworkByID(conn, globalNetworkID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return globalNetwork, aws.StringValue(globalNetwork.State), nil
+	}
+}
+
+// SiteStatus fetches the Site and its State
+func SiteStatus(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		site, err := finder.SiteByGlobalNetworkIDAndSiteID(conn, globalNetworkID, siteID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return site, aws.StringValue(site.State), nil
+	}
+}
+
+// DeviceStatus fetches the Device and its State
+func DeviceStatus(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		device, err := finder.DeviceByGlobalNetworkIDAndDeviceID(conn, globalNetworkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return device, aws.StringValue(device.State), nil
+	}
+}
+
+// LinkStatus fetches the Link and its State
+func LinkStatus(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		link, err := finder.LinkByGlobalNetworkIDAndLinkID(conn, globalNetworkID, linkID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return link, aws.StringValue(link.State), nil
+	}
+}
+
+// LinkAssociationStatus fetches the Link Association and its State
+func LinkAssociationStatus(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		linkAssociation, err := finder.LinkAssociationByGlobalNetworkIDLinkIDAndDeviceID(conn, globalNetworkID, linkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return linkAssociation, aws.StringValue(linkAssociation.LinkAssociationState), nil
+	}
+}
+
+// TransitGatewayRegistrationStatus fetches the Transit Gateway Registration and its State
+func TransitGatewayRegistrationStatus(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayRegistration, err := finder.TransitGatewayRegistrationByGlobalNetworkIDAndTransitGatewayARN(conn, globalNetworkID, transitGatewayARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if transitGatewayRegistration.State == nil {
+			return transitGatewayRegistration, "", nil
+		}
+
+		return transitGatewayRegistration, aws.StringValue(transitGatewayRegistration.State.Code), nil
+	}
+}
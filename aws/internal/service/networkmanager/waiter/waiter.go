@@ -0,0 +1,352 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Global Network to be created
+	GlobalNetworkCreatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Global Network to be updated
+	GlobalNetworkUpdatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Global Network to be deleted
+	GlobalNetworkDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Site to be created
+	SiteCreatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Site to be updated
+	SiteUpdatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Site to be deleted
+	SiteDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Device to be created
+	DeviceCreatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Device to be updated
+	DeviceUpdatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Device to be deleted
+	DeviceDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Link to be created
+	LinkCreatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Link to be updated
+	LinkUpdatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Link to be deleted
+	LinkDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Link Association to be created
+	LinkAssociationCreatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Link Association to be deleted
+	LinkAssociationDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Transit Gateway Registration to be created
+	TransitGatewayRegistrationCreatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Transit Gateway Registration to be deleted
+	TransitGatewayRegistrationDeletedTimeout = 10 * time.Minute
+)
+
+// GlobalNetworkCreated waits for a Global Network to be created
+func GlobalNetworkCreated(conn *networkmanager.NetworkManager, globalNetworkID string) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStatePending},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: GlobalNetworkStatus(conn, globalNetworkID),
+		Timeout: GlobalNetworkCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkUpdated waits for a Global Network to be updated
+func GlobalNetworkUpdated(conn *networkmanager.NetworkManager, globalNetworkID string) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateUpdating},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: GlobalNetworkStatus(conn, globalNetworkID),
+		Timeout: GlobalNetworkUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkDeleted waits for a Global Network to be deleted
+func GlobalNetworkDeleted(conn *networkmanager.NetworkManager, globalNetworkID string) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateDeleting},
+		Target:  []string{},
+		Refresh: GlobalNetworkStatus(conn, globalNetworkID),
+		Timeout: GlobalNetworkDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteCreated waits for a Site to be created
+func SiteCreated(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStatePending},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Refresh: SiteStatus(conn, globalNetworkID, siteID),
+		Timeout: SiteCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteUpdated waits for a Site to be updated
+func SiteUpdated(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateUpdating},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Refresh: SiteStatus(conn, globalNetworkID, siteID),
+		Timeout: SiteUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteDeleted waits for a Site to be deleted
+func SiteDeleted(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateDeleting},
+		Target:  []string{},
+		Refresh: SiteStatus(conn, globalNetworkID, siteID),
+		Timeout: SiteDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceCreated waits for a Device to be created
+func DeviceCreated(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStatePending},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Refresh: DeviceStatus(conn, globalNetworkID, deviceID),
+		Timeout: DeviceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceUpdated waits for a Device to be updated
+func DeviceUpdated(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateUpdating},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Refresh: DeviceStatus(conn, globalNetworkID, deviceID),
+		Timeout: DeviceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceDeleted waits for a Device to be deleted
+func DeviceDeleted(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateDeleting},
+		Target:  []string{},
+		Refresh: DeviceStatus(conn, globalNetworkID, deviceID),
+		Timeout: DeviceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkCreated waits for a Link to be created
+func LinkCreated(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStatePending},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Refresh: LinkStatus(conn, globalNetworkID, linkID),
+		Timeout: LinkCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkUpdated waits for a Link to be updated
+func LinkUpdated(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateUpdating},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Refresh: LinkStatus(conn, globalNetworkID, linkID),
+		Timeout: LinkUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkDeleted waits for a Link to be deleted
+func LinkDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateDeleting},
+		Target:  []string{},
+		Refresh: LinkStatus(conn, globalNetworkID, linkID),
+		Timeout: LinkDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationCreated waits for a Link Association to be created
+func LinkAssociationCreated(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStatePending},
+		Target:  []string{networkmanager.LinkAssociationStateAvailable},
+		Refresh: LinkAssociationStatus(conn, globalNetworkID, linkID, deviceID),
+		Timeout: LinkAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationDeleted waits for a Link Association to be deleted
+func LinkAssociationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStateDeleting},
+		Target:  []string{},
+		Refresh: LinkAssociationStatus(conn, globalNetworkID, linkID, deviceID),
+		Timeout: LinkAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationCreated waits for a Transit Gateway Registration to be created
+func TransitGatewayRegistrationCreated(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStatePending},
+		Target:  []string{networkmanager.TransitGatewayRegistrationStateAvailable},
+		Refresh: TransitGatewayRegistrationStatus(conn, globalNetworkID, transitGatewayARN),
+		Timeout: TransitGatewayRegistrationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if err != nil && output.State != nil && aws.StringValue(output.State.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			return output, fmt.Errorf("%w: %s", err, aws.StringValue(output.State.Message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationDeleted waits for a Transit Gateway Registration to be deleted
+func TransitGatewayRegistrationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStateDeleting},
+		Target:  []string{},
+		Refresh: TransitGatewayRegistrationStatus(conn, globalNetworkID, transitGatewayARN),
+		Timeout: TransitGatewayRegistrationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,40 @@
+package route53
+
+// Enum values missing from AWS Go SDK:
+// https://docs.aws.amazon.com/sdk-for-go/api/service/route53/#pkg-constants
+
+const (
+	KeySigningKeyStatusActionNeeded    = "ACTION_NEEDED"
+	KeySigningKeyStatusActive          = "ACTIVE"
+	KeySigningKeyStatusDeleting        = "DELETING"
+	KeySigningKeyStatusInactive        = "INACTIVE"
+	KeySigningKeyStatusInternalFailure = "INTERNAL_FAILURE"
+)
+
+func KeySigningKeyStatus_Values() []string {
+	return []string{
+		KeySigningKeyStatusActionNeeded,
+		KeySigningKeyStatusActive,
+		KeySigningKeyStatusDeleting,
+		KeySigningKeyStatusInactive,
+		KeySigningKeyStatusInternalFailure,
+	}
+}
+
+const (
+	ServeSignatureActionNeeded    = "ACTION_NEEDED"
+	ServeSignatureDeleting        = "DELETING"
+	ServeSignatureInternalFailure = "INTERNAL_FAILURE"
+	ServeSignatureNotSigning      = "NOT_SIGNING"
+	ServeSignatureSigning         = "SIGNING"
+)
+
+func ServeSignature_Values() []string {
+	return []string{
+		ServeSignatureActionNeeded,
+		ServeSignatureDeleting,
+		ServeSignatureInternalFailure,
+		ServeSignatureNotSigning,
+		ServeSignatureSigning,
+	}
+}
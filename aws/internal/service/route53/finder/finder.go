@@ -0,0 +1,62 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// HostedZoneDnssec returns the DNSSEC details of the specified hosted zone.
+// Returns NotFoundError if the hosted zone is not found.
+func HostedZoneDnssec(conn *route53.Route53, hostedZoneID string) (*route53.GetDNSSECOutput, error) {
+	input := &route53.GetDNSSECInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	output, err := conn.GetDNSSEC(input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// KeySigningKey returns the key-signing key corresponding to the specified hosted zone ID and name.
+// Returns NotFoundError if no key-signing key is found.
+func KeySigningKey(conn *route53.Route53, hostedZoneID string, name string) (*route53.KeySigningKey, error) {
+	output, err := HostedZoneDnssec(conn, hostedZoneID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, keySigningKey := range output.KeySigningKeys {
+		if keySigningKey == nil {
+			continue
+		}
+
+		if aws.StringValue(keySigningKey.Name) == name {
+			return keySigningKey, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: "Empty result",
+	}
+}
@@ -0,0 +1,66 @@
+package route53_test
+
+import (
+	"testing"
+
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+)
+
+func TestKeySigningKeyParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedHostedZoneID string
+		ExpectedName         string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "incorrect format",
+			InputID:       "test",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing name",
+			InputID:       "Z1234567890ABC,",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "Z1234567890ABC,example,extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              tfroute53.KeySigningKeyCreateResourceID("Z1234567890ABC", "example"),
+			ExpectedHostedZoneID: "Z1234567890ABC",
+			ExpectedName:         "example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotHostedZoneID, gotName, err := tfroute53.KeySigningKeyParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotHostedZoneID != testCase.ExpectedHostedZoneID {
+				t.Errorf("got hosted zone ID %s, expected %s", gotHostedZoneID, testCase.ExpectedHostedZoneID)
+			}
+
+			if gotName != testCase.ExpectedName {
+				t.Errorf("got name %s, expected %s", gotName, testCase.ExpectedName)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// HostedZoneDnssecStatus fetches the DNSSEC signing status of a hosted zone
+func HostedZoneDnssecStatus(conn *route53.Route53, hostedZoneID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.HostedZoneDnssec(conn, hostedZoneID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.Status.ServeSignature)
+
+		// Failure states are not transitional, so fail fast with the service's explanation.
+		if status == tfroute53.ServeSignatureActionNeeded || status == tfroute53.ServeSignatureInternalFailure {
+			return output.Status, status, fmt.Errorf("%s: %s", status, aws.StringValue(output.Status.StatusMessage))
+		}
+
+		return output.Status, status, nil
+	}
+}
+
+// KeySigningKeyStatus fetches the KeySigningKey and its Status
+func KeySigningKeyStatus(conn *route53.Route53, hostedZoneID string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		keySigningKey, err := finder.KeySigningKey(conn, hostedZoneID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(keySigningKey.Status)
+
+		if status == tfroute53.KeySigningKeyStatusActionNeeded || status == tfroute53.KeySigningKeyStatusInternalFailure {
+			return keySigningKey, status, fmt.Errorf("%s: %s", status, aws.StringValue(keySigningKey.StatusMessage))
+		}
+
+		return keySigningKey, status, nil
+	}
+}
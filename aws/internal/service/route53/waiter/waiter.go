@@ -0,0 +1,52 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a hosted zone's DNSSEC signing status to update
+	HostedZoneDnssecStatusTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a KeySigningKey's status to update
+	KeySigningKeyStatusTimeout = 5 * time.Minute
+)
+
+// HostedZoneDnssecStatusUpdated waits for a hosted zone's DNSSEC signing status to reach the specified value
+func HostedZoneDnssecStatusUpdated(conn *route53.Route53, hostedZoneID string, status string) (*route53.DNSSECStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		MinTimeout: 5 * time.Second,
+		Refresh:    HostedZoneDnssecStatus(conn, hostedZoneID),
+		Target:     []string{status},
+		Timeout:    HostedZoneDnssecStatusTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53.DNSSECStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// KeySigningKeyStatusUpdated waits for a KeySigningKey's status to reach the specified value
+func KeySigningKeyStatusUpdated(conn *route53.Route53, hostedZoneID string, name string, status string) (*route53.KeySigningKey, error) {
+	stateConf := &resource.StateChangeConf{
+		MinTimeout: 5 * time.Second,
+		Refresh:    KeySigningKeyStatus(conn, hostedZoneID, name),
+		Target:     []string{status},
+		Timeout:    KeySigningKeyStatusTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53.KeySigningKey); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -7,6 +7,8 @@ import (
 )
 
 const (
+	AccountAssignmentStatusUnknown          = "Unknown"
+	AccountAssignmentStatusNotFound         = "NotFound"
 	PermissionSetProvisioningStatusUnknown  = "Unknown"
 	PermissionSetProvisioningStatusNotFound = "NotFound"
 )
@@ -31,3 +33,45 @@ func PermissionSetProvisioningStatus(conn *ssoadmin.SSOAdmin, instanceArn, reque
 		return resp.PermissionSetProvisioningStatus, aws.StringValue(resp.PermissionSetProvisioningStatus.Status), nil
 	}
 }
+
+func AccountAssignmentCreationStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentCreationStatusInput{
+			AccountAssignmentCreationRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		resp, err := conn.DescribeAccountAssignmentCreationStatus(input)
+
+		if err != nil {
+			return nil, AccountAssignmentStatusUnknown, err
+		}
+
+		if resp == nil || resp.AccountAssignmentCreationStatus == nil {
+			return nil, AccountAssignmentStatusNotFound, nil
+		}
+
+		return resp.AccountAssignmentCreationStatus, aws.StringValue(resp.AccountAssignmentCreationStatus.Status), nil
+	}
+}
+
+func AccountAssignmentDeletionStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentDeletionStatusInput{
+			AccountAssignmentDeletionRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		resp, err := conn.DescribeAccountAssignmentDeletionStatus(input)
+
+		if err != nil {
+			return nil, AccountAssignmentStatusUnknown, err
+		}
+
+		if resp == nil || resp.AccountAssignmentDeletionStatus == nil {
+			return nil, AccountAssignmentStatusNotFound, nil
+		}
+
+		return resp.AccountAssignmentDeletionStatus, aws.StringValue(resp.AccountAssignmentDeletionStatus.Status), nil
+	}
+}
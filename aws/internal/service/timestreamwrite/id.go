@@ -0,0 +1,25 @@
+package timestreamwrite
+
+import (
+	"fmt"
+	"strings"
+)
+
+const tableResourceIDSeparator = ":"
+
+func TableCreateResourceID(tableName, databaseName string) string {
+	parts := []string{tableName, databaseName}
+	id := strings.Join(parts, tableResourceIDSeparator)
+
+	return id
+}
+
+func TableParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, tableResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TABLENAME%[2]sDATABASENAME", id, tableResourceIDSeparator)
+}
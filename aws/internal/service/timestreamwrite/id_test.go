@@ -0,0 +1,64 @@
+package timestreamwrite
+
+import (
+	"testing"
+)
+
+func TestTableParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedTableName    string
+		ExpectedDatabaseName string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing database name",
+			InputID:       "example-table:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing table name",
+			InputID:       ":example-database",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "example-table:example-database:extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              TableCreateResourceID("example-table", "example-database"),
+			ExpectedTableName:    "example-table",
+			ExpectedDatabaseName: "example-database",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotTableName, gotDatabaseName, err := TableParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotTableName != testCase.ExpectedTableName {
+				t.Errorf("got table name %s, expected %s", gotTableName, testCase.ExpectedTableName)
+			}
+
+			if gotDatabaseName != testCase.ExpectedDatabaseName {
+				t.Errorf("got database name %s, expected %s", gotDatabaseName, testCase.ExpectedDatabaseName)
+			}
+		})
+	}
+}
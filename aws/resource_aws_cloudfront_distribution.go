@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,6 +34,8 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 		MigrateState:  resourceAwsCloudFrontDistributionMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceAwsCloudFrontDistributionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -63,10 +67,15 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
-						"default_ttl": {
-							Type:     schema.TypeInt,
+						"cache_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  86400,
+						},
+						"default_ttl": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -74,7 +83,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -142,19 +151,30 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
 						},
 						"path_pattern": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -225,10 +245,15 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
-						"default_ttl": {
-							Type:     schema.TypeInt,
+						"cache_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  86400,
+						},
+						"default_ttl": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -236,7 +261,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -304,14 +329,25 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
+						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
 						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
@@ -656,6 +692,49 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 	}
 }
 
+func resourceAwsCloudFrontDistributionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if err := validateCloudFrontCacheBehaviorCacheKeySettings(diff, "default_cache_behavior.0"); err != nil {
+		return err
+	}
+
+	for i := range diff.Get("ordered_cache_behavior").([]interface{}) {
+		if err := validateCloudFrontCacheBehaviorCacheKeySettings(diff, fmt.Sprintf("ordered_cache_behavior.%d", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontCacheBehaviorCacheKeySettings ensures that exactly one of a cache policy or
+// the legacy forwarded_values settings is configured for the cache behavior at the specified path.
+func validateCloudFrontCacheBehaviorCacheKeySettings(diff *schema.ResourceDiff, prefix string) error {
+	if !diff.NewValueKnown(prefix + ".cache_policy_id") {
+		return nil
+	}
+
+	hasCachePolicy := diff.Get(prefix+".cache_policy_id").(string) != ""
+	hasForwardedValues := len(diff.Get(prefix+".forwarded_values").([]interface{})) > 0
+
+	if hasCachePolicy && hasForwardedValues {
+		return fmt.Errorf("%s: only one of cache_policy_id or forwarded_values can be configured", prefix)
+	}
+
+	if !hasCachePolicy && !hasForwardedValues {
+		return fmt.Errorf("%s: one of cache_policy_id or forwarded_values must be configured", prefix)
+	}
+
+	return nil
+}
+
+// suppressCloudFrontCacheBehaviorTTLWithCachePolicy ignores the legacy TTL settings of a cache behavior
+// that uses a cache policy, as the TTLs are then managed by the cache policy.
+func suppressCloudFrontCacheBehaviorTTLWithCachePolicy(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".")]
+
+	return d.Get(prefix+".cache_policy_id").(string) != ""
+}
+
 func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
 
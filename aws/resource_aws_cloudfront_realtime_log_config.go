@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const cloudFrontRealtimeLogConfigStreamTypeKinesis = "Kinesis"
+
+func resourceAwsCloudFrontRealtimeLogConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontRealtimeLogConfigCreate,
+		Read:   resourceAwsCloudFrontRealtimeLogConfigRead,
+		Update: resourceAwsCloudFrontRealtimeLogConfigUpdate,
+		Delete: resourceAwsCloudFrontRealtimeLogConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_stream_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"stream_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{cloudFrontRealtimeLogConfigStreamTypeKinesis}, false),
+						},
+					},
+				},
+			},
+			"fields": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sampling_rate": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontRealtimeLogConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateRealtimeLogConfigInput{
+		EndPoints:    expandCloudFrontEndPoints(d.Get("endpoint").([]interface{})),
+		Fields:       expandStringSet(d.Get("fields").(*schema.Set)),
+		Name:         aws.String(name),
+		SamplingRate: aws.Int64(int64(d.Get("sampling_rate").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Real-time Log Config: %s", input)
+	output, err := conn.CreateRealtimeLogConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Real-time Log Config (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RealtimeLogConfig.ARN))
+
+	return resourceAwsCloudFrontRealtimeLogConfigRead(d, meta)
+}
+
+func resourceAwsCloudFrontRealtimeLogConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	logConfig, err := finder.RealtimeLogConfigByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Real-time Log Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Real-time Log Config (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", logConfig.ARN)
+	if err := d.Set("endpoint", flattenCloudFrontEndPoints(logConfig.EndPoints)); err != nil {
+		return fmt.Errorf("error setting endpoint: %w", err)
+	}
+	if err := d.Set("fields", aws.StringValueSlice(logConfig.Fields)); err != nil {
+		return fmt.Errorf("error setting fields: %w", err)
+	}
+	d.Set("name", logConfig.Name)
+	d.Set("sampling_rate", logConfig.SamplingRate)
+
+	return nil
+}
+
+func resourceAwsCloudFrontRealtimeLogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	// All parameters are replaced on update, so the complete configuration is always sent.
+	input := &cloudfront.UpdateRealtimeLogConfigInput{
+		ARN:          aws.String(d.Id()),
+		EndPoints:    expandCloudFrontEndPoints(d.Get("endpoint").([]interface{})),
+		Fields:       expandStringSet(d.Get("fields").(*schema.Set)),
+		SamplingRate: aws.Int64(int64(d.Get("sampling_rate").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Real-time Log Config: %s", input)
+	_, err := conn.UpdateRealtimeLogConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Real-time Log Config (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontRealtimeLogConfigRead(d, meta)
+}
+
+func resourceAwsCloudFrontRealtimeLogConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Real-time Log Config (%s)", d.Id())
+	_, err := conn.DeleteRealtimeLogConfig(&cloudfront.DeleteRealtimeLogConfigInput{
+		ARN: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchRealtimeLogConfig) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Real-time Log Config (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontEndPoints(tfList []interface{}) []*cloudfront.EndPoint {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*cloudfront.EndPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudfront.EndPoint{}
+
+		if v, ok := tfMap["kinesis_stream_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.KinesisStreamConfig = expandCloudFrontKinesisStreamConfig(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["stream_type"].(string); ok && v != "" {
+			apiObject.StreamType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCloudFrontKinesisStreamConfig(tfMap map[string]interface{}) *cloudfront.KinesisStreamConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.KinesisStreamConfig{}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleARN = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_arn"].(string); ok && v != "" {
+		apiObject.StreamARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudFrontEndPoints(apiObjects []*cloudfront.EndPoint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.KinesisStreamConfig; v != nil {
+			tfMap["kinesis_stream_config"] = []interface{}{flattenCloudFrontKinesisStreamConfig(v)}
+		}
+
+		if v := apiObject.StreamType; v != nil {
+			tfMap["stream_type"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenCloudFrontKinesisStreamConfig(apiObject *cloudfront.KinesisStreamConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RoleARN; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StreamARN; v != nil {
+		tfMap["stream_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 )
 
 func init() {
@@ -94,6 +96,58 @@ func testSweepCloudwatchLogGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestResourceAwsCloudWatchLogGroupRead(t *testing.T) {
+	server := fakeaws.NewServer(t)
+	server.Handle("DescribeLogGroups",
+		fakeaws.ThrottlingResponse(fakeaws.ProtocolJSON),
+		fakeaws.JSONResponse(`{"logGroups": [{"arn": "arn:aws:logs:us-west-2:123456789012:log-group:test:*", "logGroupName": "test", "retentionInDays": 7}]}`), //lintignore:AWSAT003,AWSAT005
+	)
+	server.Handle("ListTagsLogGroup", fakeaws.JSONResponse(`{"tags": {"key1": "value1"}}`))
+
+	d := schema.TestResourceDataRaw(t, resourceAwsCloudWatchLogGroup().Schema, map[string]interface{}{
+		"name": "test",
+	})
+	d.SetId("test")
+
+	if err := resourceAwsCloudWatchLogGroupRead(d, testFakeAWSClient(t, server)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := d.Get("arn").(string), "arn:aws:logs:us-west-2:123456789012:log-group:test"; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got arn %s, expected %s", got, expected)
+	}
+
+	if got, expected := d.Get("retention_in_days").(int), 7; got != expected {
+		t.Errorf("got retention_in_days %d, expected %d", got, expected)
+	}
+
+	if got, expected := d.Get("tags.key1").(string), "value1"; got != expected {
+		t.Errorf("got tags.key1 %s, expected %s", got, expected)
+	}
+}
+
+func TestResourceAwsCloudWatchLogGroupRead_NotFound(t *testing.T) {
+	server := fakeaws.NewServer(t)
+	server.Handle("DescribeLogGroups", fakeaws.JSONResponse(`{"logGroups": []}`))
+
+	d := schema.TestResourceDataRaw(t, resourceAwsCloudWatchLogGroup().Schema, map[string]interface{}{
+		"name": "test",
+	})
+	d.SetId("test")
+
+	if err := resourceAwsCloudWatchLogGroupRead(d, testFakeAWSClient(t, server)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("got ID %s, expected resource to be removed from state", d.Id())
+	}
+
+	if got, expected := len(server.Requests("ListTagsLogGroup")), 0; got != expected {
+		t.Errorf("got %d ListTagsLogGroup requests, expected %d", got, expected)
+	}
+}
+
 func TestAccAWSCloudWatchLogGroup_basic(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
 	rInt := acctest.RandInt()
@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsConnectContactFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConnectContactFlowCreate,
+		Read:   resourceAwsConnectContactFlowRead,
+		Update: resourceAwsConnectContactFlowUpdate,
+		Delete: resourceAwsConnectContactFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_flow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connect.ContactFlowTypeContactFlow,
+				ValidateFunc: validation.StringInSlice(connect.ContactFlowType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsConnectContactFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	input := &connect.CreateContactFlowInput{
+		Content:    aws.String(d.Get("content").(string)),
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		Type:       aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ConnectTags()
+	}
+
+	log.Printf("[DEBUG] Creating Connect Contact Flow: %s", input)
+	output, err := conn.CreateContactFlow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Connect Contact Flow (%s): %w", name, err)
+	}
+
+	d.SetId(tfconnect.ContactFlowCreateResourceID(instanceID, aws.StringValue(output.ContactFlowId)))
+
+	return resourceAwsConnectContactFlowRead(d, meta)
+}
+
+func resourceAwsConnectContactFlowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	contactFlow, err := finder.ContactFlowByInstanceIDAndContactFlowID(conn, instanceID, contactFlowID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Contact Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Connect Contact Flow (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", contactFlow.Arn)
+	d.Set("contact_flow_id", contactFlow.Id)
+	d.Set("content", contactFlow.Content)
+	d.Set("description", contactFlow.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", contactFlow.Name)
+	d.Set("type", contactFlow.Type)
+
+	tags := keyvaluetags.ConnectKeyValueTags(contactFlow.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectContactFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &connect.UpdateContactFlowNameInput{
+			ContactFlowId: aws.String(contactFlowID),
+			Description:   aws.String(d.Get("description").(string)),
+			InstanceId:    aws.String(instanceID),
+			Name:          aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Contact Flow name: %s", input)
+		_, err := conn.UpdateContactFlowName(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Connect Contact Flow (%s) name: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("content") {
+		input := &connect.UpdateContactFlowContentInput{
+			ContactFlowId: aws.String(contactFlowID),
+			Content:       aws.String(d.Get("content").(string)),
+			InstanceId:    aws.String(instanceID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Contact Flow content: %s", input)
+		_, err := conn.UpdateContactFlowContent(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Connect Contact Flow (%s) content: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ConnectUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Connect Contact Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsConnectContactFlowRead(d, meta)
+}
+
+func resourceAwsConnectContactFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	// Contact Flows cannot be deleted via the API.
+	log.Printf("[WARN] Connect Contact Flow (%s) cannot be deleted, removing from state only", d.Id())
+
+	return nil
+}
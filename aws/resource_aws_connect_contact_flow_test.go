@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSConnectContactFlow_basic(t *testing.T) {
+	resourceName := "aws_connect_contact_flow.test"
+	instanceResourceName := "aws_connect_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectContactFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfigBasic(rName, "Created", "Thanks for calling the sample flow!"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+/contact-flow/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_flow_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", instanceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", connect.ContactFlowTypeContactFlow),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigBasic(rName, "Updated", "Thanks for calling the updated sample flow!"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectContactFlow_Tags(t *testing.T) {
+	resourceName := "aws_connect_contact_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectContactFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// Contact Flows cannot be deleted, so they are considered destroyed once their Instance is gone.
+func testAccCheckAWSConnectContactFlowDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_contact_flow" {
+			continue
+		}
+
+		instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ContactFlowByInstanceIDAndContactFlowID(conn, instanceID, contactFlowID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Contact Flow (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSConnectContactFlowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Contact Flow ID is set")
+		}
+
+		instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		_, err = finder.ContactFlowByInstanceIDAndContactFlowID(conn, instanceID, contactFlowID)
+
+		return err
+	}
+}
+
+func testAccAWSConnectContactFlowConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccAWSConnectContactFlowContent(text string) string {
+	return fmt.Sprintf(`
+  content = jsonencode({
+    Version     = "2019-10-30"
+    StartAction = "12345678-1234-1234-1234-123456789012"
+    Actions = [
+      {
+        Identifier = "12345678-1234-1234-1234-123456789012"
+        Type       = "MessageParticipant"
+        Transitions = {
+          NextAction = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+          Errors     = []
+          Conditions = []
+        }
+        Parameters = {
+          Text = %[1]q
+        }
+      },
+      {
+        Identifier  = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+        Type        = "DisconnectParticipant"
+        Transitions = {}
+        Parameters  = {}
+      }
+    ]
+  })
+`, text)
+}
+
+func testAccAWSConnectContactFlowConfigBasic(rName, description, text string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  description = %[2]q
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+%[3]s
+}
+`, rName, description, testAccAWSConnectContactFlowContent(text)))
+}
+
+func testAccAWSConnectContactFlowConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccAWSConnectContactFlowContent("Thanks for calling the sample flow!")))
+}
+
+func testAccAWSConnectContactFlowConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccAWSConnectContactFlowContent("Thanks for calling the sample flow!")))
+}
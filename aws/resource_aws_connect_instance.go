@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsConnectInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConnectInstanceCreate,
+		Read:   resourceAwsConnectInstanceRead,
+		Update: resourceAwsConnectInstanceUpdate,
+		Delete: resourceAwsConnectInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(12, 12),
+			},
+			"identity_management_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.DirectoryType_Values(), false),
+			},
+			"inbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"instance_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 62),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]+(-*[a-zA-Z0-9])*$`), "must contain only alphanumeric characters and hyphens, and must begin and end with an alphanumeric character"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`^d-`), "cannot begin with d-"),
+				),
+			},
+			"outbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsConnectInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	input := &connect.CreateInstanceInput{
+		ClientToken:            aws.String(resource.UniqueId()),
+		IdentityManagementType: aws.String(d.Get("identity_management_type").(string)),
+		InboundCallsEnabled:    aws.Bool(d.Get("inbound_calls_enabled").(bool)),
+		OutboundCallsEnabled:   aws.Bool(d.Get("outbound_calls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		input.DirectoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_alias"); ok {
+		input.InstanceAlias = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Connect Instance: %s", input)
+	output, err := conn.CreateInstance(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Connect Instance: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waiter.InstanceCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Connect Instance (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsConnectInstanceRead(d, meta)
+}
+
+func resourceAwsConnectInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	instance, err := finder.InstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Connect Instance (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", instance.Arn)
+	if instance.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(instance.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("identity_management_type", instance.IdentityManagementType)
+	d.Set("inbound_calls_enabled", instance.InboundCallsEnabled)
+	d.Set("instance_alias", instance.InstanceAlias)
+	d.Set("outbound_calls_enabled", instance.OutboundCallsEnabled)
+	d.Set("service_role", instance.ServiceRole)
+	d.Set("status", instance.InstanceStatus)
+
+	return nil
+}
+
+func resourceAwsConnectInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	if d.HasChange("inbound_calls_enabled") {
+		if err := connectUpdateInstanceAttribute(conn, d.Id(), connect.InstanceAttributeTypeInboundCalls, d.Get("inbound_calls_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("outbound_calls_enabled") {
+		if err := connectUpdateInstanceAttribute(conn, d.Id(), connect.InstanceAttributeTypeOutboundCalls, d.Get("outbound_calls_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsConnectInstanceRead(d, meta)
+}
+
+func resourceAwsConnectInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	log.Printf("[DEBUG] Deleting Connect Instance (%s)", d.Id())
+	_, err := conn.DeleteInstance(&connect.DeleteInstanceInput{
+		InstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Connect Instance (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.InstanceDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Connect Instance (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func connectUpdateInstanceAttribute(conn *connect.Connect, instanceID, attributeType string, value bool) error {
+	input := &connect.UpdateInstanceAttributeInput{
+		AttributeType: aws.String(attributeType),
+		InstanceId:    aws.String(instanceID),
+		Value:         aws.String(strconv.FormatBool(value)),
+	}
+
+	log.Printf("[DEBUG] Updating Connect Instance attribute: %s", input)
+	_, err := conn.UpdateInstanceAttribute(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Connect Instance (%s) attribute (%s): %w", instanceID, attributeType, err)
+	}
+
+	return nil
+}
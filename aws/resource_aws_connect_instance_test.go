@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
+		Name: "aws_connect_instance",
+		F:    testSweepConnectInstances,
+	})
+}
+
+func testSweepConnectInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).connectconn
+	var sweeperErrs *multierror.Error
+
+	input := &connect.ListInstancesInput{}
+
+	err = conn.ListInstancesPages(input, func(page *connect.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, instance := range page.InstanceSummaryList {
+			if instance == nil {
+				continue
+			}
+
+			id := aws.StringValue(instance.Id)
+
+			log.Printf("[INFO] Deleting Connect Instance: %s", id)
+			r := resourceAwsConnectInstance()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting Connect Instance (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Instance sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Connect Instances: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSConnectInstance_basic(t *testing.T) {
+	resourceName := "aws_connect_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "identity_management_type", connect.DirectoryTypeConnectManaged),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_alias", rName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "true"),
+					testAccMatchResourceAttrGlobalARN(resourceName, "service_role", "iam", regexp.MustCompile(`role/aws-service-role/connect.amazonaws.com/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.InstanceStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectInstance_disappears(t *testing.T) {
+	resourceName := "aws_connect_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConnectInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectInstance_CallsEnabled(t *testing.T) {
+	resourceName := "aws_connect_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfigCallsEnabled(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectInstanceConfigCallsEnabled(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSConnect(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	input := &connect.ListInstancesInput{}
+
+	_, err := conn.ListInstances(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSConnectInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_instance" {
+			continue
+		}
+
+		_, err := finder.InstanceByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Instance (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSConnectInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		_, err := finder.InstanceByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSConnectInstanceConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccAWSConnectInstanceConfigCallsEnabled(rName string, inbound, outbound bool) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = %[2]t
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = %[3]t
+}
+`, rName, inbound, outbound)
+}
@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsEcrReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrReplicationConfigurationPut,
+		Read:   resourceAwsEcrReplicationConfigurationRead,
+		Update: resourceAwsEcrReplicationConfigurationPut,
+		Delete: resourceAwsEcrReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MaxItems: 25,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"region": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"registry_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateAwsAccountId,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsEcrReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	input := &ecr.PutReplicationConfigurationInput{
+		ReplicationConfiguration: expandEcrReplicationConfiguration(d.Get("replication_configuration").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting ECR Replication Configuration: %s", input)
+	_, err := conn.PutReplicationConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting ECR Replication Configuration: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*AWSClient).accountid)
+	}
+
+	return resourceAwsEcrReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsEcrReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	output, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Replication Configuration (%s): %w", d.Id(), err)
+	}
+
+	if !d.IsNewResource() && (output.ReplicationConfiguration == nil || len(output.ReplicationConfiguration.Rules) == 0) {
+		log.Printf("[WARN] ECR Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("registry_id", output.RegistryId)
+
+	if err := d.Set("replication_configuration", flattenEcrReplicationConfiguration(output.ReplicationConfiguration)); err != nil {
+		return fmt.Errorf("error setting replication_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEcrReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	// There is no API to remove the configuration, so an empty rule list is put in its place.
+	log.Printf("[DEBUG] Deleting ECR Replication Configuration (%s)", d.Id())
+	_, err := conn.PutReplicationConfiguration(&ecr.PutReplicationConfigurationInput{
+		ReplicationConfiguration: &ecr.ReplicationConfiguration{
+			Rules: []*ecr.ReplicationRule{},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECR Replication Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEcrReplicationConfiguration(tfList []interface{}) *ecr.ReplicationConfiguration {
+	apiObject := &ecr.ReplicationConfiguration{
+		Rules: []*ecr.ReplicationRule{},
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandEcrReplicationRules(v)
+	}
+
+	return apiObject
+}
+
+func expandEcrReplicationRules(tfList []interface{}) []*ecr.ReplicationRule {
+	var apiObjects []*ecr.ReplicationRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecr.ReplicationRule{}
+
+		if v, ok := tfMap["destination"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Destinations = expandEcrReplicationDestinations(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEcrReplicationDestinations(tfList []interface{}) []*ecr.ReplicationDestination {
+	var apiObjects []*ecr.ReplicationDestination
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecr.ReplicationDestination{}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		if v, ok := tfMap["registry_id"].(string); ok && v != "" {
+			apiObject.RegistryId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEcrReplicationConfiguration(apiObject *ecr.ReplicationConfiguration) []interface{} {
+	if apiObject == nil || len(apiObject.Rules) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"rule": flattenEcrReplicationRules(apiObject.Rules),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEcrReplicationRules(apiObjects []*ecr.ReplicationRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"destination": flattenEcrReplicationDestinations(apiObject.Destinations),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEcrReplicationDestinations(apiObjects []*ecr.ReplicationDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Region; v != nil {
+			tfMap["region"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.RegistryId; v != nil {
+			tfMap["registry_id"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
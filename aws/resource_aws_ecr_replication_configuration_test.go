@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSEcrReplicationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_ecr_replication_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccMultipleRegionPreCheck(t, 2) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrReplicationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrReplicationConfiguration(testAccGetAlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrReplicationConfigurationExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.destination.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replication_configuration.0.rule.0.destination.*", map[string]string{
+						"region": testAccGetAlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrReplicationConfiguration_MultipleDestinations(t *testing.T) {
+	resourceName := "aws_ecr_replication_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccMultipleRegionPreCheck(t, 3) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrReplicationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrReplicationConfigurationMultipleDestinations(testAccGetAlternateRegion(), testAccGetThirdRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.destination.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replication_configuration.0.rule.0.destination.*", map[string]string{
+						"region": testAccGetAlternateRegion(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replication_configuration.0.rule.0.destination.*", map[string]string{
+						"region": testAccGetThirdRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEcrReplicationConfiguration(testAccGetThirdRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.destination.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replication_configuration.0.rule.0.destination.*", map[string]string{
+						"region": testAccGetThirdRegion(),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcrReplicationConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Replication Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+
+		output, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if output.ReplicationConfiguration == nil || len(output.ReplicationConfiguration.Rules) == 0 {
+			return fmt.Errorf("ECR Replication Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEcrReplicationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_replication_configuration" {
+			continue
+		}
+
+		output, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if output.ReplicationConfiguration != nil && len(output.ReplicationConfiguration.Rules) > 0 {
+			return fmt.Errorf("ECR Replication Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEcrReplicationConfiguration(region string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+    }
+  }
+}
+`, region)
+}
+
+func testAccAWSEcrReplicationConfigurationMultipleDestinations(region1, region2 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      destination {
+        region      = %[2]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+    }
+  }
+}
+`, region1, region2)
+}
@@ -0,0 +1,338 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsEcrPublicRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrPublicRepositoryCreate,
+		Read:   resourceAwsEcrPublicRepositoryRead,
+		Update: resourceAwsEcrPublicRepositoryUpdate,
+		Delete: resourceAwsEcrPublicRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"catalog_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"about_text": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 10240),
+						},
+						"architectures": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 50),
+							},
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"logo_image_blob": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+						"operating_systems": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 50),
+							},
+						},
+						"usage_text": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 10240),
+						},
+					},
+				},
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 205),
+					validation.StringMatch(regexp.MustCompile(`^(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*$`), "see: https://docs.aws.amazon.com/AmazonECRPublic/latest/APIReference/API_CreateRepository.html#API_CreateRepository_RequestSyntax"),
+				),
+			},
+			"repository_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEcrPublicRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	name := d.Get("repository_name").(string)
+	input := &ecrpublic.CreateRepositoryInput{
+		RepositoryName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("catalog_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CatalogData = expandEcrPublicRepositoryCatalogDataInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating ECR Public Repository: %s", input)
+	output, err := conn.CreateRepository(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ECR Public Repository (%s): %w", name, err)
+	}
+
+	if output == nil || output.Repository == nil {
+		return fmt.Errorf("error creating ECR Public Repository (%s): empty output", name)
+	}
+
+	d.SetId(aws.StringValue(output.Repository.RepositoryName))
+
+	return resourceAwsEcrPublicRepositoryRead(d, meta)
+}
+
+func resourceAwsEcrPublicRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	input := &ecrpublic.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{d.Id()}),
+	}
+
+	var output *ecrpublic.DescribeRepositoriesOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.DescribeRepositories(input)
+
+		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = conn.DescribeRepositories(input)
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+		log.Printf("[WARN] ECR Public Repository (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Public Repository (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || len(output.Repositories) == 0 || output.Repositories[0] == nil {
+		return fmt.Errorf("error reading ECR Public Repository (%s): empty response", d.Id())
+	}
+
+	repository := output.Repositories[0]
+
+	d.Set("arn", repository.RepositoryArn)
+	d.Set("registry_id", repository.RegistryId)
+	d.Set("repository_name", repository.RepositoryName)
+	d.Set("repository_uri", repository.RepositoryUri)
+
+	catalogOutput, err := conn.GetRepositoryCatalogData(&ecrpublic.GetRepositoryCatalogDataInput{
+		RegistryId:     repository.RegistryId,
+		RepositoryName: repository.RepositoryName,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading catalog data for ECR Public Repository (%s): %w", d.Id(), err)
+	}
+
+	if catalogOutput != nil {
+		if err := d.Set("catalog_data", flattenEcrPublicRepositoryCatalogData(catalogOutput.CatalogData, d)); err != nil {
+			return fmt.Errorf("error setting catalog_data: %w", err)
+		}
+	} else {
+		d.Set("catalog_data", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsEcrPublicRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	if d.HasChange("catalog_data") {
+		input := &ecrpublic.PutRepositoryCatalogDataInput{
+			CatalogData:    &ecrpublic.RepositoryCatalogDataInput{},
+			RegistryId:     aws.String(d.Get("registry_id").(string)),
+			RepositoryName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("catalog_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.CatalogData = expandEcrPublicRepositoryCatalogDataInput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating ECR Public Repository catalog data: %s", input)
+		_, err := conn.PutRepositoryCatalogData(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating catalog data for ECR Public Repository (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEcrPublicRepositoryRead(d, meta)
+}
+
+func resourceAwsEcrPublicRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	log.Printf("[DEBUG] Deleting ECR Public Repository (%s)", d.Id())
+	_, err := conn.DeleteRepository(&ecrpublic.DeleteRepositoryInput{
+		Force:          aws.Bool(d.Get("force_destroy").(bool)),
+		RegistryId:     aws.String(d.Get("registry_id").(string)),
+		RepositoryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECR Public Repository (%s): %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Waiting for ECR Public Repository (%s) to be deleted", d.Id())
+	input := &ecrpublic.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{d.Id()}),
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DescribeRepositories(input)
+
+		if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("ECR Public Repository (%s) still exists", d.Id()))
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DescribeRepositories(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for ECR Public Repository (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEcrPublicRepositoryCatalogDataInput(tfMap map[string]interface{}) *ecrpublic.RepositoryCatalogDataInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecrpublic.RepositoryCatalogDataInput{}
+
+	if v, ok := tfMap["about_text"].(string); ok && v != "" {
+		apiObject.AboutText = aws.String(v)
+	}
+
+	if v, ok := tfMap["architectures"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Architectures = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["logo_image_blob"].(string); ok && v != "" {
+		// The value has already been validated as base64 encoded.
+		data, _ := base64.StdEncoding.DecodeString(v)
+		apiObject.LogoImageBlob = data
+	}
+
+	if v, ok := tfMap["operating_systems"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.OperatingSystems = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["usage_text"].(string); ok && v != "" {
+		apiObject.UsageText = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEcrPublicRepositoryCatalogData(apiObject *ecrpublic.RepositoryCatalogData, d *schema.ResourceData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"about_text":        aws.StringValue(apiObject.AboutText),
+		"architectures":     flattenStringSet(apiObject.Architectures),
+		"description":       aws.StringValue(apiObject.Description),
+		"operating_systems": flattenStringSet(apiObject.OperatingSystems),
+		"usage_text":        aws.StringValue(apiObject.UsageText),
+	}
+
+	// The API only returns a URL for the uploaded logo, so keep the configured image data.
+	if v, ok := d.GetOk("catalog_data.0.logo_image_blob"); ok {
+		tfMap["logo_image_blob"] = v.(string)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsEcrPublicRepositoryPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrPublicRepositoryPolicyPut,
+		Read:   resourceAwsEcrPublicRepositoryPolicyRead,
+		Update: resourceAwsEcrPublicRepositoryPolicyPut,
+		Delete: resourceAwsEcrPublicRepositoryPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEcrPublicRepositoryPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	name := d.Get("repository_name").(string)
+	input := &ecrpublic.SetRepositoryPolicyInput{
+		PolicyText:     aws.String(d.Get("policy").(string)),
+		RepositoryName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Setting ECR Public Repository Policy: %s", input)
+
+	// Retry due to IAM eventual consistency
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.SetRepositoryPolicy(input)
+
+		if tfawserr.ErrMessageContains(err, ecrpublic.ErrCodeInvalidParameterException, "Invalid repository policy provided") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.SetRepositoryPolicy(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting ECR Public Repository Policy (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsEcrPublicRepositoryPolicyRead(d, meta)
+}
+
+func resourceAwsEcrPublicRepositoryPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	output, err := conn.GetRepositoryPolicy(&ecrpublic.GetRepositoryPolicyInput{
+		RepositoryName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) || tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryPolicyNotFoundException)) {
+		log.Printf("[WARN] ECR Public Repository Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Public Repository Policy (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading ECR Public Repository Policy (%s): empty response", d.Id())
+	}
+
+	d.Set("policy", output.PolicyText)
+	d.Set("registry_id", output.RegistryId)
+	d.Set("repository_name", output.RepositoryName)
+
+	return nil
+}
+
+func resourceAwsEcrPublicRepositoryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	log.Printf("[DEBUG] Deleting ECR Public Repository Policy (%s)", d.Id())
+	_, err := conn.DeleteRepositoryPolicy(&ecrpublic.DeleteRepositoryPolicyInput{
+		RegistryId:     aws.String(d.Get("registry_id").(string)),
+		RepositoryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) || tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryPolicyNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECR Public Repository Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSEcrPublicRepositoryPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsEcrPublic(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryPolicyConfig(rName, "ecr-public:DescribeImages"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryPolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_name", "aws_ecrpublic_repository.test", "repository_name"),
+					testAccCheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile("ecr-public:DescribeImages")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEcrPublicRepositoryPolicyConfig(rName, "ecr-public:DescribeRepositories"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryPolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile("ecr-public:DescribeRepositories")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepositoryPolicy_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsEcrPublic(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryPolicyConfig(rName, "ecr-public:DescribeImages"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEcrPublicRepositoryPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcrPublicRepositoryPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecrpublic_repository_policy" {
+			continue
+		}
+
+		_, err := conn.GetRepositoryPolicy(&ecrpublic.GetRepositoryPolicyInput{
+			RepositoryName: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) || tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryPolicyNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECR Public Repository Policy (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEcrPublicRepositoryPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Public Repository Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+		_, err := conn.GetRepositoryPolicy(&ecrpublic.GetRepositoryPolicyInput{
+			RepositoryName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSEcrPublicRepositoryPolicyConfig(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+}
+
+resource "aws_ecrpublic_repository_policy" "test" {
+  repository_name = aws_ecrpublic_repository.test.repository_name
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "testpolicy",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": [
+        %[2]q
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName, action)
+}
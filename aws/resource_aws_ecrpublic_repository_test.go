@@ -0,0 +1,356 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
+		Name: "aws_ecrpublic_repository",
+		F:    testSweepEcrPublicRepositories,
+	})
+}
+
+func testSweepEcrPublicRepositories(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).ecrpublicconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeRepositoriesPages(&ecrpublic.DescribeRepositoriesInput{}, func(page *ecrpublic.DescribeRepositoriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, repository := range page.Repositories {
+			repositoryName := aws.StringValue(repository.RepositoryName)
+			log.Printf("[INFO] Deleting ECR Public Repository: %s", repositoryName)
+
+			_, err := conn.DeleteRepository(&ecrpublic.DeleteRepositoryInput{
+				// We should probably sweep repositories even if there are images.
+				Force:          aws.Bool(true),
+				RegistryId:     repository.RegistryId,
+				RepositoryName: repository.RepositoryName,
+			})
+
+			if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting ECR Public Repository (%s): %w", repositoryName, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping ECR Public Repository sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving ECR Public Repositories: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSEcrPublicRepository_basic(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsEcrPublic(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "repository_name", rName),
+					testAccCheckResourceAttrAccountID(resourceName, "registry_id"),
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "ecr-public", fmt.Sprintf("repository/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "repository_uri"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_disappears(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsEcrPublic(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEcrPublicRepository(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_CatalogData(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsEcrPublic(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigCatalogData(rName, "about text 1", "description 1", "usage text 1", "ARM", "Linux"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.about_text", "about text 1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.architectures.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.architectures.*", "ARM"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.operating_systems.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.operating_systems.*", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.usage_text", "usage text 1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigCatalogData(rName, "about text 2", "description 2", "usage text 2", "x86-64", "Windows"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.about_text", "about text 2"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.architectures.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.architectures.*", "x86-64"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.operating_systems.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.operating_systems.*", "Windows"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.usage_text", "usage text 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_CatalogData_LogoImageBlob(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsEcrPublic(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigCatalogDataLogoImageBlob(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_data.0.logo_image_blob"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"catalog_data.0.logo_image_blob", "force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_ForceDestroy(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsEcrPublic(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigForceDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func testAccPreCheckAwsEcrPublic(t *testing.T) {
+	// At present, only us-east-1 is supported for the ECR Public API.
+	// The provider client is pinned to that region, so only the partition matters here.
+	if testAccGetPartition() != endpoints.AwsPartitionID {
+		t.Skipf("skipping acceptance testing: ECR Public is not supported in partition %s", testAccGetPartition())
+	}
+
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+	input := &ecrpublic.DescribeRepositoriesInput{}
+
+	_, err := conn.DescribeRepositories(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSEcrPublicRepositoryExists(name string, res *ecrpublic.Repository) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Public Repository ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+		output, err := conn.DescribeRepositories(&ecrpublic.DescribeRepositoriesInput{
+			RepositoryNames: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || len(output.Repositories) == 0 || output.Repositories[0] == nil {
+			return fmt.Errorf("ECR Public Repository (%s) not found", rs.Primary.ID)
+		}
+
+		*res = *output.Repositories[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEcrPublicRepositoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecrpublic_repository" {
+			continue
+		}
+
+		output, err := conn.DescribeRepositories(&ecrpublic.DescribeRepositoriesInput{
+			RepositoryNames: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, repository := range output.Repositories {
+			if aws.StringValue(repository.RepositoryName) == rs.Primary.ID {
+				return fmt.Errorf("ECR Public Repository (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEcrPublicRepositoryConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSEcrPublicRepositoryConfigCatalogData(rName, aboutText, description, usageText, architecture, operatingSystem string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+
+  catalog_data {
+    about_text        = %[2]q
+    architectures     = [%[5]q]
+    description       = %[3]q
+    operating_systems = [%[6]q]
+    usage_text        = %[4]q
+  }
+}
+`, rName, aboutText, description, usageText, architecture, operatingSystem)
+}
+
+func testAccAWSEcrPublicRepositoryConfigCatalogDataLogoImageBlob(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+
+  catalog_data {
+    logo_image_blob = filebase64("test-fixtures/ecrpublic_logo.png")
+  }
+}
+`, rName)
+}
+
+func testAccAWSEcrPublicRepositoryConfigForceDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+  force_destroy   = true
+}
+`, rName)
+}
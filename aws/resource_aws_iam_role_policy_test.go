@@ -206,7 +206,7 @@ func TestAccAWSIAMRolePolicy_Policy_InvalidResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccIAMRolePolicyConfig_Policy_InvalidResource(rName),
-				ExpectError: regexp.MustCompile("MalformedPolicyDocument"),
+				ExpectError: regexp.MustCompile("Resource must be a string or a list of strings"),
 			},
 		},
 	})
@@ -0,0 +1,623 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsPipeline() *schema.Resource {
+	activityNameSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		}
+	}
+
+	activityNextSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		}
+	}
+
+	enrichActivitySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attribute": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+					"name": activityNameSchema(),
+					"next": activityNextSchema(),
+					"role_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateArn,
+					},
+					"thing_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+				},
+			},
+		}
+	}
+
+	attributesActivitySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attributes": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						MaxItems: 50,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+					"name": activityNameSchema(),
+					"next": activityNextSchema(),
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsPipelineCreate,
+		Read:   resourceAwsIotAnalyticsPipelineRead,
+		Update: resourceAwsIotAnalyticsPipelineUpdate,
+		Delete: resourceAwsIotAnalyticsPipelineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": activityNameSchema(),
+									"next": activityNextSchema(),
+								},
+							},
+						},
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIotAnalyticsName,
+									},
+									"name": activityNameSchema(),
+									"next": activityNextSchema(),
+								},
+							},
+						},
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIotAnalyticsName,
+									},
+									"name": activityNameSchema(),
+								},
+							},
+						},
+						"device_registry_enrich": enrichActivitySchema(),
+						"device_shadow_enrich":   enrichActivitySchema(),
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": activityNameSchema(),
+									"next": activityNextSchema(),
+								},
+							},
+						},
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"lambda_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"name": activityNameSchema(),
+									"next": activityNextSchema(),
+								},
+							},
+						},
+						"math": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"math": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": activityNameSchema(),
+									"next": activityNextSchema(),
+								},
+							},
+						},
+						"remove_attributes": attributesActivitySchema(),
+						"select_attributes": attributesActivitySchema(),
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsPipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandIotAnalyticsPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Pipeline: %s", input)
+	_, err := conn.CreatePipeline(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Pipeline (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsPipelineRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsPipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	pipeline, err := finder.PipelineByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Pipeline (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenIotAnalyticsPipelineActivities(pipeline.Activities)); err != nil {
+		return fmt.Errorf("error setting activity: %w", err)
+	}
+	arn := aws.StringValue(pipeline.Arn)
+	d.Set("arn", arn)
+	d.Set("name", pipeline.Name)
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Pipeline (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChange("activity") {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandIotAnalyticsPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Pipeline: %s", input)
+		_, err := conn.UpdatePipeline(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Pipeline (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Pipeline (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsPipelineRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsPipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Pipeline (%s)", d.Id())
+	_, err := conn.DeletePipeline(&iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Pipeline (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// firstIotAnalyticsBlock returns the single nested configuration block under key, or nil if not configured.
+func firstIotAnalyticsBlock(tfMap map[string]interface{}, key string) map[string]interface{} {
+	if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsPipelineActivity(tfMap map[string]interface{}) *iotanalytics.PipelineActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.PipelineActivity{}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "add_attributes"); tfMap != nil {
+		apiObject.AddAttributes = &iotanalytics.AddAttributesActivity{
+			Attributes: stringMapToPointers(tfMap["attributes"].(map[string]interface{})),
+			Name:       aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.AddAttributes.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "channel"); tfMap != nil {
+		apiObject.Channel = &iotanalytics.ChannelActivity{
+			ChannelName: aws.String(tfMap["channel_name"].(string)),
+			Name:        aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.Channel.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "datastore"); tfMap != nil {
+		apiObject.Datastore = &iotanalytics.DatastoreActivity{
+			DatastoreName: aws.String(tfMap["datastore_name"].(string)),
+			Name:          aws.String(tfMap["name"].(string)),
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "device_registry_enrich"); tfMap != nil {
+		apiObject.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			RoleArn:   aws.String(tfMap["role_arn"].(string)),
+			ThingName: aws.String(tfMap["thing_name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.DeviceRegistryEnrich.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "device_shadow_enrich"); tfMap != nil {
+		apiObject.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			RoleArn:   aws.String(tfMap["role_arn"].(string)),
+			ThingName: aws.String(tfMap["thing_name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.DeviceShadowEnrich.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "filter"); tfMap != nil {
+		apiObject.Filter = &iotanalytics.FilterActivity{
+			Filter: aws.String(tfMap["filter"].(string)),
+			Name:   aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.Filter.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "lambda"); tfMap != nil {
+		apiObject.Lambda = &iotanalytics.LambdaActivity{
+			BatchSize:  aws.Int64(int64(tfMap["batch_size"].(int))),
+			LambdaName: aws.String(tfMap["lambda_name"].(string)),
+			Name:       aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.Lambda.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "math"); tfMap != nil {
+		apiObject.Math = &iotanalytics.MathActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Math:      aws.String(tfMap["math"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.Math.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "remove_attributes"); tfMap != nil {
+		apiObject.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+			Attributes: expandStringList(tfMap["attributes"].([]interface{})),
+			Name:       aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.RemoveAttributes.Next = aws.String(v)
+		}
+	}
+
+	if tfMap := firstIotAnalyticsBlock(tfMap, "select_attributes"); tfMap != nil {
+		apiObject.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+			Attributes: expandStringList(tfMap["attributes"].([]interface{})),
+			Name:       aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["next"].(string); ok && v != "" {
+			apiObject.SelectAttributes.Next = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsPipelineActivities(tfList []interface{}) []*iotanalytics.PipelineActivity {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotanalytics.PipelineActivity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandIotAnalyticsPipelineActivity(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIotAnalyticsPipelineActivity(apiObject *iotanalytics.PipelineActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AddAttributes; v != nil {
+		tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueMap(v.Attributes),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Channel; v != nil {
+		tfMap["channel"] = []interface{}{map[string]interface{}{
+			"channel_name": aws.StringValue(v.ChannelName),
+			"name":         aws.StringValue(v.Name),
+			"next":         aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Datastore; v != nil {
+		tfMap["datastore"] = []interface{}{map[string]interface{}{
+			"datastore_name": aws.StringValue(v.DatastoreName),
+			"name":           aws.StringValue(v.Name),
+		}}
+	}
+
+	if v := apiObject.DeviceRegistryEnrich; v != nil {
+		tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":  aws.StringValue(v.Attribute),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+			"role_arn":   aws.StringValue(v.RoleArn),
+			"thing_name": aws.StringValue(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.DeviceShadowEnrich; v != nil {
+		tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":  aws.StringValue(v.Attribute),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+			"role_arn":   aws.StringValue(v.RoleArn),
+			"thing_name": aws.StringValue(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{map[string]interface{}{
+			"filter": aws.StringValue(v.Filter),
+			"name":   aws.StringValue(v.Name),
+			"next":   aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = []interface{}{map[string]interface{}{
+			"batch_size":  aws.Int64Value(v.BatchSize),
+			"lambda_name": aws.StringValue(v.LambdaName),
+			"name":        aws.StringValue(v.Name),
+			"next":        aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Math; v != nil {
+		tfMap["math"] = []interface{}{map[string]interface{}{
+			"attribute": aws.StringValue(v.Attribute),
+			"math":      aws.StringValue(v.Math),
+			"name":      aws.StringValue(v.Name),
+			"next":      aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.RemoveAttributes; v != nil {
+		tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueSlice(v.Attributes),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.SelectAttributes; v != nil {
+		tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueSlice(v.Attributes),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenIotAnalyticsPipelineActivities(apiObjects []*iotanalytics.PipelineActivity) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenIotAnalyticsPipelineActivity(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,409 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotanalytics_pipeline", &resource.Sweeper{
+		Name: "aws_iotanalytics_pipeline",
+		F:    testSweepIotAnalyticsPipelines,
+	})
+}
+
+func testSweepIotAnalyticsPipelines(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).iotanalyticsconn
+	var sweeperErrs *multierror.Error
+
+	input := &iotanalytics.ListPipelinesInput{}
+
+	err = conn.ListPipelinesPages(input, func(page *iotanalytics.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.PipelineSummaries {
+			if summary == nil {
+				continue
+			}
+
+			name := aws.StringValue(summary.PipelineName)
+
+			log.Printf("[INFO] Deleting IoT Analytics Pipeline: %s", name)
+			r := resourceAwsIotAnalyticsPipeline()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Analytics Pipeline (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Pipeline sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Analytics Pipelines: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotAnalyticsPipeline_basic(t *testing.T) {
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccAWSIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(`pipeline/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_datastore.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_disappears(t *testing.T) {
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccAWSIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsPipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_Tags(t *testing.T) {
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccAWSIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_Activities(t *testing.T) {
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccAWSIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigActivities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.add_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.add_attributes.0.attributes.temperature", "temperature_copy"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.filter.0.filter", "temperature > 40"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.math.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.math.0.attribute", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.5.datastore.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsPipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_pipeline" {
+			continue
+		}
+
+		_, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Pipeline (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIotAnalyticsPipelineExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		_, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigActivities(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "add_attributes_activity"
+    }
+  }
+
+  activity {
+    add_attributes {
+      attributes = {
+        temperature = "temperature_copy"
+      }
+      name = "add_attributes_activity"
+      next = "filter_activity"
+    }
+  }
+
+  activity {
+    filter {
+      filter = "temperature > 40"
+      name   = "filter_activity"
+      next   = "math_activity"
+    }
+  }
+
+  activity {
+    math {
+      attribute = "temperature_f"
+      math      = "(temperature * 9 / 5) + 32"
+      name      = "math_activity"
+      next      = "remove_attributes_activity"
+    }
+  }
+
+  activity {
+    remove_attributes {
+      attributes = ["temperature_copy"]
+      name       = "remove_attributes_activity"
+      next       = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,328 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotEventsDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsDetectorModelCreate,
+		Read:   resourceAwsIotEventsDetectorModelRead,
+		Update: resourceAwsIotEventsDetectorModelUpdate,
+		Delete: resourceAwsIotEventsDetectorModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentIotEventsDetectorModelDefinitions,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotEventsDetectorModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	definition, err := expandIotEventsDetectorModelDefinition(d.Get("definition").(string))
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: definition,
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Detector Model: %s", input)
+	err = resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.CreateDetectorModel(input)
+
+		if tfawserr.ErrMessageContains(err, iotevents.ErrCodeInvalidRequestException, "role") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.CreateDetectorModel(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Detector Model (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.DetectorModelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	arn := aws.StringValue(configuration.DetectorModelArn)
+	d.Set("arn", arn)
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	definition, err := flattenIotEventsDetectorModelDefinition(output.DetectorModelDefinition)
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Detector Model (%s) definition: %w", d.Id(), err)
+	}
+
+	d.Set("definition", definition)
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsDetectorModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		definition, err := expandIotEventsDetectorModelDefinition(d.Get("definition").(string))
+
+		if err != nil {
+			return err
+		}
+
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  definition,
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		// Each update creates a new version of the detector model which must activate before it is used.
+		log.Printf("[DEBUG] Updating IoT Events Detector Model: %s", input)
+		_, err = conn.UpdateDetectorModel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Detector Model (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model (%s)", d.Id())
+	_, err := conn.DeleteDetectorModel(&iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotEventsDetectorModelDefinition(s string) (*iotevents.DetectorModelDefinition, error) {
+	var apiObject *iotevents.DetectorModelDefinition
+
+	if err := json.Unmarshal([]byte(s), &apiObject); err != nil {
+		return nil, fmt.Errorf("error decoding IoT Events Detector Model definition JSON: %w", err)
+	}
+
+	return apiObject, nil
+}
+
+func flattenIotEventsDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// suppressEquivalentIotEventsDetectorModelDefinitions compares the canonical API form of both definitions
+// so that differences in key casing, whitespace or explicit nulls do not show as changes.
+func suppressEquivalentIotEventsDetectorModelDefinitions(k, old, new string, d *schema.ResourceData) bool {
+	oldDefinition, err := expandIotEventsDetectorModelDefinition(old)
+
+	if err != nil {
+		return false
+	}
+
+	newDefinition, err := expandIotEventsDetectorModelDefinition(new)
+
+	if err != nil {
+		return false
+	}
+
+	oldJSON, err := jsonutil.BuildJSON(oldDefinition)
+
+	if err != nil {
+		return false
+	}
+
+	newJSON, err := jsonutil.BuildJSON(newDefinition)
+
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(oldJSON, newJSON)
+}
@@ -0,0 +1,409 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotevents_detector_model", &resource.Sweeper{
+		Name: "aws_iotevents_detector_model",
+		F:    testSweepIotEventsDetectorModels,
+	})
+}
+
+func testSweepIotEventsDetectorModels(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ioteventsconn
+	var sweeperErrs *multierror.Error
+
+	input := &iotevents.ListDetectorModelsInput{}
+
+	for {
+		output, err := conn.ListDetectorModels(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Detector Model sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Events Detector Models: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, summary := range output.DetectorModelSummaries {
+			if summary == nil {
+				continue
+			}
+
+			name := aws.StringValue(summary.DetectorModelName)
+
+			log.Printf("[INFO] Deleting IoT Events Detector Model: %s", name)
+			r := resourceAwsIotEventsDetectorModel()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Events Detector Model (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotEventsDetectorModel_basic(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotevents", regexp.MustCompile(`detectorModel/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodBatch),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_disappears(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Definition(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_KeyAndEvaluationMethod(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigKeyAndEvaluationMethod(rName, iotevents.EvaluationMethodSerial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test detector model"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodSerial),
+					resource.TestCheckResourceAttr(resourceName, "key", "sensorId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigKeyAndEvaluationMethod(rName, iotevents.EvaluationMethodBatch),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodBatch),
+					resource.TestCheckResourceAttr(resourceName, "key", "sensorId"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Tags(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsDetectorModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		_, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Detector Model (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIotEventsDetectorModelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		_, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSIotEventsDetectorModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "sensorId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+// testAccAWSIotEventsDetectorModelDefinition returns a single-state definition that
+// sets a variable on entry and compares incoming temperatures against it.
+func testAccAWSIotEventsDetectorModelDefinition(threshold string) string {
+	return fmt.Sprintf(`
+  definition = jsonencode({
+    initialStateName = "monitoring"
+    states = [{
+      stateName = "monitoring"
+      onEnter = {
+        events = [{
+          eventName = "init"
+          condition = "true"
+          actions = [{
+            setVariable = {
+              variableName = "threshold"
+              value        = %[1]q
+            }
+          }]
+        }]
+      }
+      onInput = {
+        events = [{
+          eventName = "high_temperature"
+          condition = "$input.${aws_iotevents_input.test.name}.temperature > $variable.threshold"
+          actions = [{
+            setVariable = {
+              variableName = "threshold"
+              value        = "$input.${aws_iotevents_input.test.name}.temperature"
+            }
+          }]
+        }]
+      }
+    }]
+  })
+`, threshold)
+}
+
+func testAccAWSIotEventsDetectorModelConfigBasic(rName, threshold string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s}
+`, rName, testAccAWSIotEventsDetectorModelDefinition(threshold)))
+}
+
+func testAccAWSIotEventsDetectorModelConfigKeyAndEvaluationMethod(rName, evaluationMethod string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name              = %[1]q
+  description       = "test detector model"
+  evaluation_method = %[2]q
+  key               = "sensorId"
+  role_arn          = aws_iam_role.test.arn
+%[3]s}
+`, rName, evaluationMethod, testAccAWSIotEventsDetectorModelDefinition("0")))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccAWSIotEventsDetectorModelDefinition("0")))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccAWSIotEventsDetectorModelDefinition("0")))
+}
@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotevents_input", &resource.Sweeper{
+		Name:         "aws_iotevents_input",
+		F:            testSweepIotEventsInputs,
+		Dependencies: []string{"aws_iotevents_detector_model"},
+	})
+}
+
+func testSweepIotEventsInputs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ioteventsconn
+	var sweeperErrs *multierror.Error
+
+	input := &iotevents.ListInputsInput{}
+
+	for {
+		output, err := conn.ListInputs(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Input sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Events Inputs: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, summary := range output.InputSummaries {
+			if summary == nil {
+				continue
+			}
+
+			name := aws.StringValue(summary.InputName)
+
+			log.Printf("[INFO] Deleting IoT Events Input: %s", name)
+			r := resourceAwsIotEventsInput()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Events Input (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotEventsInput_basic(t *testing.T) {
+	resourceName := "aws_iotevents_input.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotevents", regexp.MustCompile(`input/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_disappears(t *testing.T) {
+	resourceName := "aws_iotevents_input.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsInput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_Definition(t *testing.T) {
+	resourceName := "aws_iotevents_input.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigDefinition(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.0.json_path", "sensorId"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.1.json_path", "sensorData.temperature"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_Tags(t *testing.T) {
+	resourceName := "aws_iotevents_input.test"
+	rName := testAccAWSIotEventsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsInputDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_input" {
+			continue
+		}
+
+		_, err := finder.InputByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Input (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIotEventsInputExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Input ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		_, err := finder.InputByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPreCheckAWSIotEvents(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	input := &iotevents.ListInputsInput{}
+
+	_, err := conn.ListInputs(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccAWSIotEventsResourceName returns a random name that satisfies the
+// IoT Events input naming rules, which do not permit hyphens.
+func testAccAWSIotEventsResourceName() string {
+	return fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+}
+
+func testAccAWSIotEventsInputConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsInputConfigDefinition(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  definition {
+    attribute {
+      json_path = "sensorId"
+    }
+
+    attribute {
+      json_path = "sensorData.temperature"
+    }
+  }
+}
+`, rName, description)
+}
+
+func testAccAWSIotEventsInputConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotEventsInputConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
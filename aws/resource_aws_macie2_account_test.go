@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsMacie2Account_basic(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2AccountConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					testAccCheckResourceAttrGlobalARN(resourceName, "service_role", "iam", "role/aws-service-role/macie.amazonaws.com/AWSServiceRoleForAmazonMacie"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_disappears(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2AccountConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2AccountExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Account(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_FindingPublishingFrequency(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencyFifteenMinutes),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+				),
+			},
+			{
+				Config: testAccAWSMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencySixHours),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencySixHours),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_Status(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2AccountConfigStatus(macie2.MacieStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAWSMacie2AccountConfigStatus(macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMacie2AccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_account" {
+			continue
+		}
+
+		_, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Account (%s) still enabled", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMacie2AccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+
+		return err
+	}
+}
+
+func testAccAWSMacie2AccountConfigBasic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+`
+}
+
+func testAccAWSMacie2AccountConfigFindingPublishingFrequency(frequency string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  finding_publishing_frequency = %[1]q
+}
+`, frequency)
+}
+
+func testAccAWSMacie2AccountConfigStatus(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  status = %[1]q
+}
+`, status)
+}
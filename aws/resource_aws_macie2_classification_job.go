@@ -0,0 +1,773 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+)
+
+func resourceAwsMacie2ClassificationJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2ClassificationJobCreate,
+		Read:   resourceAwsMacie2ClassificationJobRead,
+		Update: resourceAwsMacie2ClassificationJobUpdate,
+		Delete: resourceAwsMacie2ClassificationJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_data_identifier_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"initial_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					macie2.JobStatusCancelled,
+					macie2.JobStatusRunning,
+					macie2.JobStatusUserPaused,
+				}, false),
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(macie2.JobType_Values(), false),
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(0, 500),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(0, 500-resource.UniqueIDSuffixLength),
+			},
+			"s3_job_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_definitions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"buckets": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"scoping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excludes": macie2ClassificationJobScopingBlockSchema(),
+									"includes": macie2ClassificationJobScopingBlockSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sampling_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"schedule_frequency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_schedule": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"monthly_schedule": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 31),
+						},
+						"weekly_schedule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(macie2.DayOfWeek_Values(), false),
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"user_paused_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_imminent_expiration_health_event_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_paused_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func macie2ClassificationJobScopingBlockSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"and": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"simple_scope_term": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Computed:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"key": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.ScopeFilterKey_Values(), false),
+										},
+										"values": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"tag_scope_term": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Computed:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"key": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+										"tag_values": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"key": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+										"target": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Computed:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.TagTarget_Values(), false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2ClassificationJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &macie2.CreateClassificationJobInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		JobType:     aws.String(d.Get("job_type").(string)),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("custom_data_identifier_ids"); ok && len(v.([]interface{})) > 0 {
+		input.CustomDataIdentifierIds = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("initial_run"); ok {
+		input.InitialRun = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("s3_job_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3JobDefinition = expandMacie2S3JobDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sampling_percentage"); ok {
+		input.SamplingPercentage = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("schedule_frequency"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScheduleFrequency = expandMacie2JobScheduleFrequency(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Classification Job: %s", input)
+	output, err := conn.CreateClassificationJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Classification Job (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if v, ok := d.GetOk("job_status"); ok && v.(string) != macie2.JobStatusRunning {
+		if err := macie2UpdateClassificationJobStatus(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie Classification Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Classification Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("custom_data_identifier_ids", aws.StringValueSlice(output.CustomDataIdentifierIds)); err != nil {
+		return fmt.Errorf("error setting custom_data_identifier_ids: %w", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("initial_run", output.InitialRun)
+	d.Set("job_arn", output.JobArn)
+	d.Set("job_id", output.JobId)
+	d.Set("job_status", output.JobStatus)
+	d.Set("job_type", output.JobType)
+	d.Set("name", output.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(output.Name)))
+	if output.S3JobDefinition != nil {
+		if err := d.Set("s3_job_definition", []interface{}{flattenMacie2S3JobDefinition(output.S3JobDefinition)}); err != nil {
+			return fmt.Errorf("error setting s3_job_definition: %w", err)
+		}
+	} else {
+		d.Set("s3_job_definition", nil)
+	}
+	d.Set("sampling_percentage", output.SamplingPercentage)
+	if output.ScheduleFrequency != nil {
+		if err := d.Set("schedule_frequency", []interface{}{flattenMacie2JobScheduleFrequency(output.ScheduleFrequency)}); err != nil {
+			return fmt.Errorf("error setting schedule_frequency: %w", err)
+		}
+	} else {
+		d.Set("schedule_frequency", nil)
+	}
+	if output.UserPausedDetails != nil {
+		if err := d.Set("user_paused_details", []interface{}{flattenMacie2UserPausedDetails(output.UserPausedDetails)}); err != nil {
+			return fmt.Errorf("error setting user_paused_details: %w", err)
+		}
+	} else {
+		d.Set("user_paused_details", nil)
+	}
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2ClassificationJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("job_status") {
+		if err := macie2UpdateClassificationJobStatus(conn, d.Id(), d.Get("job_status").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("job_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Classification Job (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	// Classification jobs cannot be deleted, only cancelled.
+	output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Classification Job (%s): %w", d.Id(), err)
+	}
+
+	switch aws.StringValue(output.JobStatus) {
+	case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling Macie Classification Job (%s)", d.Id())
+	return macie2UpdateClassificationJobStatus(conn, d.Id(), macie2.JobStatusCancelled)
+}
+
+func macie2UpdateClassificationJobStatus(conn *macie2.Macie2, id, status string) error {
+	input := &macie2.UpdateClassificationJobInput{
+		JobId:     aws.String(id),
+		JobStatus: aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Macie Classification Job: %s", input)
+	_, err := conn.UpdateClassificationJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Macie Classification Job (%s) status to %s: %w", id, status, err)
+	}
+
+	return nil
+}
+
+func expandMacie2S3JobDefinition(tfMap map[string]interface{}) *macie2.S3JobDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.S3JobDefinition{}
+
+	if v, ok := tfMap["bucket_definitions"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			bucketDefinition := &macie2.S3BucketDefinitionForJob{}
+
+			if v, ok := tfMap["account_id"].(string); ok && v != "" {
+				bucketDefinition.AccountId = aws.String(v)
+			}
+
+			if v, ok := tfMap["buckets"].([]interface{}); ok && len(v) > 0 {
+				bucketDefinition.Buckets = expandStringList(v)
+			}
+
+			apiObject.BucketDefinitions = append(apiObject.BucketDefinitions, bucketDefinition)
+		}
+	}
+
+	if v, ok := tfMap["scoping"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		scoping := &macie2.Scoping{}
+
+		if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			scoping.Excludes = expandMacie2JobScopingBlock(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			scoping.Includes = expandMacie2JobScopingBlock(v[0].(map[string]interface{}))
+		}
+
+		apiObject.Scoping = scoping
+	}
+
+	return apiObject
+}
+
+func expandMacie2JobScopingBlock(tfMap map[string]interface{}) *macie2.JobScopingBlock {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.JobScopingBlock{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			jobScopeTerm := &macie2.JobScopeTerm{}
+
+			if v, ok := tfMap["simple_scope_term"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				simpleScopeTerm := &macie2.SimpleScopeTerm{}
+
+				if v, ok := tfMap["comparator"].(string); ok && v != "" {
+					simpleScopeTerm.Comparator = aws.String(v)
+				}
+
+				if v, ok := tfMap["key"].(string); ok && v != "" {
+					simpleScopeTerm.Key = aws.String(v)
+				}
+
+				if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+					simpleScopeTerm.Values = expandStringList(v)
+				}
+
+				jobScopeTerm.SimpleScopeTerm = simpleScopeTerm
+			}
+
+			if v, ok := tfMap["tag_scope_term"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				tagScopeTerm := &macie2.TagScopeTerm{}
+
+				if v, ok := tfMap["comparator"].(string); ok && v != "" {
+					tagScopeTerm.Comparator = aws.String(v)
+				}
+
+				if v, ok := tfMap["key"].(string); ok && v != "" {
+					tagScopeTerm.Key = aws.String(v)
+				}
+
+				if v, ok := tfMap["tag_values"].([]interface{}); ok && len(v) > 0 {
+					for _, tfMapRaw := range v {
+						tfMap, ok := tfMapRaw.(map[string]interface{})
+
+						if !ok {
+							continue
+						}
+
+						tagScopeTerm.TagValues = append(tagScopeTerm.TagValues, &macie2.TagValuePair{
+							Key:   aws.String(tfMap["key"].(string)),
+							Value: aws.String(tfMap["value"].(string)),
+						})
+					}
+				}
+
+				if v, ok := tfMap["target"].(string); ok && v != "" {
+					tagScopeTerm.Target = aws.String(v)
+				}
+
+				jobScopeTerm.TagScopeTerm = tagScopeTerm
+			}
+
+			apiObject.And = append(apiObject.And, jobScopeTerm)
+		}
+	}
+
+	return apiObject
+}
+
+func expandMacie2JobScheduleFrequency(tfMap map[string]interface{}) *macie2.JobScheduleFrequency {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.JobScheduleFrequency{}
+
+	if v, ok := tfMap["daily_schedule"].(bool); ok && v {
+		apiObject.DailySchedule = &macie2.DailySchedule{}
+	}
+
+	if v, ok := tfMap["monthly_schedule"].(int); ok && v != 0 {
+		apiObject.MonthlySchedule = &macie2.MonthlySchedule{
+			DayOfMonth: aws.Int64(int64(v)),
+		}
+	}
+
+	if v, ok := tfMap["weekly_schedule"].(string); ok && v != "" {
+		apiObject.WeeklySchedule = &macie2.WeeklySchedule{
+			DayOfWeek: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMacie2S3JobDefinition(apiObject *macie2.S3JobDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	var bucketDefinitions []interface{}
+
+	for _, v := range apiObject.BucketDefinitions {
+		if v == nil {
+			continue
+		}
+
+		bucketDefinitions = append(bucketDefinitions, map[string]interface{}{
+			"account_id": aws.StringValue(v.AccountId),
+			"buckets":    aws.StringValueSlice(v.Buckets),
+		})
+	}
+
+	tfMap["bucket_definitions"] = bucketDefinitions
+
+	if v := apiObject.Scoping; v != nil {
+		scoping := map[string]interface{}{}
+
+		if v.Excludes != nil {
+			scoping["excludes"] = []interface{}{flattenMacie2JobScopingBlock(v.Excludes)}
+		}
+
+		if v.Includes != nil {
+			scoping["includes"] = []interface{}{flattenMacie2JobScopingBlock(v.Includes)}
+		}
+
+		tfMap["scoping"] = []interface{}{scoping}
+	}
+
+	return tfMap
+}
+
+func flattenMacie2JobScopingBlock(apiObject *macie2.JobScopingBlock) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var and []interface{}
+
+	for _, v := range apiObject.And {
+		if v == nil {
+			continue
+		}
+
+		jobScopeTerm := map[string]interface{}{}
+
+		if v := v.SimpleScopeTerm; v != nil {
+			jobScopeTerm["simple_scope_term"] = []interface{}{map[string]interface{}{
+				"comparator": aws.StringValue(v.Comparator),
+				"key":        aws.StringValue(v.Key),
+				"values":     aws.StringValueSlice(v.Values),
+			}}
+		}
+
+		if v := v.TagScopeTerm; v != nil {
+			var tagValues []interface{}
+
+			for _, v := range v.TagValues {
+				if v == nil {
+					continue
+				}
+
+				tagValues = append(tagValues, map[string]interface{}{
+					"key":   aws.StringValue(v.Key),
+					"value": aws.StringValue(v.Value),
+				})
+			}
+
+			jobScopeTerm["tag_scope_term"] = []interface{}{map[string]interface{}{
+				"comparator": aws.StringValue(v.Comparator),
+				"key":        aws.StringValue(v.Key),
+				"tag_values": tagValues,
+				"target":     aws.StringValue(v.Target),
+			}}
+		}
+
+		and = append(and, jobScopeTerm)
+	}
+
+	return map[string]interface{}{
+		"and": and,
+	}
+}
+
+func flattenMacie2JobScheduleFrequency(apiObject *macie2.JobScheduleFrequency) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.DailySchedule != nil {
+		tfMap["daily_schedule"] = true
+	}
+
+	if v := apiObject.MonthlySchedule; v != nil {
+		tfMap["monthly_schedule"] = aws.Int64Value(v.DayOfMonth)
+	}
+
+	if v := apiObject.WeeklySchedule; v != nil {
+		tfMap["weekly_schedule"] = aws.StringValue(v.DayOfWeek)
+	}
+
+	return tfMap
+}
+
+func flattenMacie2UserPausedDetails(apiObject *macie2.UserPausedDetails) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_imminent_expiration_health_event_arn": aws.StringValue(apiObject.JobImminentExpirationHealthEventArn),
+	}
+
+	if v := apiObject.JobExpiresAt; v != nil {
+		tfMap["job_expires_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.JobPausedAt; v != nil {
+		tfMap["job_paused_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsMacie2ClassificationJob_basic(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "job_arn", "macie2", regexp.MustCompile(`classification-job/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeOneTime),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.#", "1"),
+					testAccCheckResourceAttrAccountID(resourceName, "s3_job_definition.0.bucket_definitions.0.account_id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.0", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_disappears(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2ClassificationJobExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2ClassificationJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_JobStatus(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.weekly_schedule", macie2.DayOfWeekMonday),
+				),
+			},
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusUserPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusUserPaused),
+					resource.TestCheckResourceAttr(resourceName, "user_paused_details.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_tags(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMacie2ClassificationJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_job" {
+			continue
+		}
+
+		output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+			JobId: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Classification jobs cannot be deleted, only cancelled.
+		switch aws.StringValue(output.JobStatus) {
+		case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+			continue
+		}
+
+		return fmt.Errorf("Macie Classification Job (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMacie2ClassificationJobExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Classification Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+			JobId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSMacie2ClassificationJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSMacie2ClassificationJobConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  job_type = "ONE_TIME"
+  name     = %[1]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName))
+}
+
+func testAccAWSMacie2ClassificationJobConfigJobStatus(rName, jobStatus string) string {
+	return composeConfig(
+		testAccAWSMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  job_status = %[2]q
+  job_type   = "SCHEDULED"
+  name       = %[1]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  schedule_frequency {
+    weekly_schedule = "MONDAY"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, jobStatus))
+}
+
+func testAccAWSMacie2ClassificationJobConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  job_type = "SCHEDULED"
+  name     = %[1]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSMacie2ClassificationJobConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  job_type = "SCHEDULED"
+  name     = %[1]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+)
+
+func testAccAwsMacie2CustomDataIdentifier_basic(t *testing.T) {
+	resourceName := "aws_macie2_custom_data_identifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2CustomDataIdentifierConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2CustomDataIdentifierExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "macie2", regexp.MustCompile(`custom-data-identifier/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "ignore_words.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "keywords.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_match_distance", "50"),
+					naming.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
+					resource.TestCheckResourceAttr(resourceName, "regex", "[0-9]{3}-[0-9]{2}-[0-9]{4}"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_disappears(t *testing.T) {
+	resourceName := "aws_macie2_custom_data_identifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2CustomDataIdentifierConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2CustomDataIdentifierExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2CustomDataIdentifier(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_NamePrefix(t *testing.T) {
+	resourceName := "aws_macie2_custom_data_identifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2CustomDataIdentifierConfigNamePrefix("tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2CustomDataIdentifierExists(resourceName),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_tags(t *testing.T) {
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2CustomDataIdentifierConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2CustomDataIdentifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMacie2CustomDataIdentifierConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2CustomDataIdentifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMacie2CustomDataIdentifierConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2CustomDataIdentifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMacie2CustomDataIdentifierDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_custom_data_identifier" {
+			continue
+		}
+
+		output, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.BoolValue(output.Deleted) {
+			continue
+		}
+
+		return fmt.Errorf("Macie Custom Data Identifier (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMacie2CustomDataIdentifierExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Custom Data Identifier ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSMacie2CustomDataIdentifierConfigBasic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  depends_on = [aws_macie2_account.test]
+}
+`
+}
+
+func testAccAWSMacie2CustomDataIdentifierConfigNamePrefix(namePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name_prefix = %[1]q
+  regex       = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  depends_on = [aws_macie2_account.test]
+}
+`, namePrefix)
+}
+
+func testAccAWSMacie2CustomDataIdentifierConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMacie2CustomDataIdentifierConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
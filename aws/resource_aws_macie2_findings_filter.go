@@ -0,0 +1,431 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+)
+
+func resourceAwsMacie2FindingsFilter() *schema.Resource {
+	integerValidateFunc := validation.StringMatch(regexp.MustCompile(`^-?[0-9]+$`), "must be an integer")
+
+	return &schema.Resource{
+		Create: resourceAwsMacie2FindingsFilterCreate,
+		Read:   resourceAwsMacie2FindingsFilterRead,
+		Update: resourceAwsMacie2FindingsFilterUpdate,
+		Delete: resourceAwsMacie2FindingsFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.FindingsFilterAction_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criterion": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eq": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"eq_exact_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"gt": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerValidateFunc,
+									},
+									"gte": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerValidateFunc,
+									},
+									"lt": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerValidateFunc,
+									},
+									"lte": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerValidateFunc,
+									},
+									"neq": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(3, 64),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(0, 64-resource.UniqueIDSuffixLength),
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMacie2FindingsFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &macie2.CreateFindingsFilterInput{
+		Action:      aws.String(d.Get("action").(string)),
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("finding_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		findingCriteria, err := expandMacie2FindingCriteria(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		input.FindingCriteria = findingCriteria
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		input.Position = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Findings Filter: %s", input)
+	output, err := conn.CreateFindingsFilter(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Findings Filter (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceAwsMacie2FindingsFilterRead(d, meta)
+}
+
+func resourceAwsMacie2FindingsFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetFindingsFilter(&macie2.GetFindingsFilterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie Findings Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Findings Filter (%s): %w", d.Id(), err)
+	}
+
+	d.Set("action", output.Action)
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	if output.FindingCriteria != nil {
+		if err := d.Set("finding_criteria", []interface{}{flattenMacie2FindingCriteria(output.FindingCriteria)}); err != nil {
+			return fmt.Errorf("error setting finding_criteria: %w", err)
+		}
+	} else {
+		d.Set("finding_criteria", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("position", output.Position)
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2FindingsFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &macie2.UpdateFindingsFilterInput{
+			Action:      aws.String(d.Get("action").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Id:          aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("finding_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			findingCriteria, err := expandMacie2FindingCriteria(v.([]interface{})[0].(map[string]interface{}))
+
+			if err != nil {
+				return err
+			}
+
+			input.FindingCriteria = findingCriteria
+		}
+
+		if d.HasChange("position") {
+			input.Position = aws.Int64(int64(d.Get("position").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating Macie Findings Filter: %s", input)
+		_, err := conn.UpdateFindingsFilter(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Macie Findings Filter (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Findings Filter (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2FindingsFilterRead(d, meta)
+}
+
+func resourceAwsMacie2FindingsFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Deleting Macie Findings Filter (%s)", d.Id())
+	_, err := conn.DeleteFindingsFilter(&macie2.DeleteFindingsFilterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Findings Filter (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMacie2FindingCriteria(tfMap map[string]interface{}) (*macie2.FindingCriteria, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiObject := &macie2.FindingCriteria{}
+
+	if v, ok := tfMap["criterion"].(*schema.Set); ok && v.Len() > 0 {
+		criterion := make(map[string]*macie2.CriterionAdditionalProperties)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			field := tfMap["field"].(string)
+			criterionAdditionalProperties, err := expandMacie2CriterionAdditionalProperties(tfMap)
+
+			if err != nil {
+				return nil, fmt.Errorf("error expanding finding criterion (%s): %w", field, err)
+			}
+
+			criterion[field] = criterionAdditionalProperties
+		}
+
+		apiObject.Criterion = criterion
+	}
+
+	return apiObject, nil
+}
+
+func expandMacie2CriterionAdditionalProperties(tfMap map[string]interface{}) (*macie2.CriterionAdditionalProperties, error) {
+	apiObject := &macie2.CriterionAdditionalProperties{}
+
+	if v, ok := tfMap["eq"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Eq = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["eq_exact_match"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EqExactMatch = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["gt"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing gt (%s): %w", v, err)
+		}
+
+		apiObject.Gt = aws.Int64(i)
+	}
+
+	if v, ok := tfMap["gte"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing gte (%s): %w", v, err)
+		}
+
+		apiObject.Gte = aws.Int64(i)
+	}
+
+	if v, ok := tfMap["lt"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing lt (%s): %w", v, err)
+		}
+
+		apiObject.Lt = aws.Int64(i)
+	}
+
+	if v, ok := tfMap["lte"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing lte (%s): %w", v, err)
+		}
+
+		apiObject.Lte = aws.Int64(i)
+	}
+
+	if v, ok := tfMap["neq"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Neq = expandStringSet(v)
+	}
+
+	return apiObject, nil
+}
+
+func flattenMacie2FindingCriteria(apiObject *macie2.FindingCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var criterion []interface{}
+
+	for field, v := range apiObject.Criterion {
+		if v == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"field": field,
+		}
+
+		if len(v.Eq) > 0 {
+			tfMap["eq"] = flattenStringSet(v.Eq)
+		}
+
+		if len(v.EqExactMatch) > 0 {
+			tfMap["eq_exact_match"] = flattenStringSet(v.EqExactMatch)
+		}
+
+		if v.Gt != nil {
+			tfMap["gt"] = strconv.FormatInt(aws.Int64Value(v.Gt), 10)
+		}
+
+		if v.Gte != nil {
+			tfMap["gte"] = strconv.FormatInt(aws.Int64Value(v.Gte), 10)
+		}
+
+		if v.Lt != nil {
+			tfMap["lt"] = strconv.FormatInt(aws.Int64Value(v.Lt), 10)
+		}
+
+		if v.Lte != nil {
+			tfMap["lte"] = strconv.FormatInt(aws.Int64Value(v.Lte), 10)
+		}
+
+		if len(v.Neq) > 0 {
+			tfMap["neq"] = flattenStringSet(v.Neq)
+		}
+
+		criterion = append(criterion, tfMap)
+	}
+
+	return map[string]interface{}{
+		"criterion": criterion,
+	}
+}
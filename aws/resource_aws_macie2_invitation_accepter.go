@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsMacie2InvitationAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2InvitationAccepterCreate,
+		Read:   resourceAwsMacie2InvitationAccepterRead,
+		Delete: resourceAwsMacie2InvitationAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"administrator_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"invitation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+		},
+	}
+}
+
+func resourceAwsMacie2InvitationAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	administratorAccountID := d.Get("administrator_account_id").(string)
+	invitationID := ""
+
+	listInvitationsInput := &macie2.ListInvitationsInput{}
+	findInvitation := func(page *macie2.ListInvitationsOutput, lastPage bool) bool {
+		for _, invitation := range page.Invitations {
+			if aws.StringValue(invitation.AccountId) == administratorAccountID {
+				invitationID = aws.StringValue(invitation.InvitationId)
+				return false
+			}
+		}
+		return !lastPage
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		log.Printf("[DEBUG] Listing Macie Invitations: %s", listInvitationsInput)
+		err := conn.ListInvitationsPages(listInvitationsInput, findInvitation)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if invitationID == "" {
+			return resource.RetryableError(fmt.Errorf("unable to find pending Macie Invitation from administrator account ID (%s)", administratorAccountID))
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		err = conn.ListInvitationsPages(listInvitationsInput, findInvitation)
+
+		if err == nil && invitationID == "" {
+			err = fmt.Errorf("unable to find pending Macie Invitation from administrator account ID (%s)", administratorAccountID)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Macie Invitations: %w", err)
+	}
+
+	input := &macie2.AcceptInvitationInput{
+		InvitationId:  aws.String(invitationID),
+		MasterAccount: aws.String(administratorAccountID),
+	}
+
+	log.Printf("[DEBUG] Accepting Macie Invitation: %s", input)
+	_, err = conn.AcceptInvitation(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting Macie Invitation (%s): %w", invitationID, err)
+	}
+
+	d.SetId(administratorAccountID)
+
+	return resourceAwsMacie2InvitationAccepterRead(d, meta)
+}
+
+func resourceAwsMacie2InvitationAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	output, err := conn.GetMasterAccount(&macie2.GetMasterAccountInput{})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie Invitation Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Administrator Account: %w", err)
+	}
+
+	if !d.IsNewResource() && (output.Master == nil || aws.StringValue(output.Master.AccountId) != d.Id()) {
+		log.Printf("[WARN] Macie Invitation Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if output.Master == nil {
+		return fmt.Errorf("error reading Macie Administrator Account: empty response")
+	}
+
+	d.Set("administrator_account_id", output.Master.AccountId)
+	d.Set("invitation_id", output.Master.InvitationId)
+
+	return nil
+}
+
+func resourceAwsMacie2InvitationAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Disassociating Macie from Administrator Account (%s)", d.Id())
+	_, err := conn.DisassociateFromMasterAccount(&macie2.DisassociateFromMasterAccountInput{})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Macie from Administrator Account (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
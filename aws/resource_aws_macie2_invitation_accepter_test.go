@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsMacie2InvitationAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_invitation_accepter.test"
+	_, email := testAccAWSMacie2MemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccPreCheckAWSMacie2(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSMacie2InvitationAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2InvitationAccepterConfigBasic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2InvitationAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "administrator_account_id", "data.aws_caller_identity.administrator", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "invitation_id"),
+				),
+			},
+			{
+				Config:            testAccAWSMacie2InvitationAccepterConfigBasic(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMacie2InvitationAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_invitation_accepter" {
+			continue
+		}
+
+		output, err := conn.GetMasterAccount(&macie2.GetMasterAccountInput{})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Master == nil || aws.StringValue(output.Master.AccountId) != rs.Primary.ID {
+			continue
+		}
+
+		return fmt.Errorf("Macie Invitation Accepter (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMacie2InvitationAccepterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Invitation Accepter ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := conn.GetMasterAccount(&macie2.GetMasterAccountInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Master == nil || aws.StringValue(output.Master.AccountId) != rs.Primary.ID {
+			return fmt.Errorf("Macie Invitation Accepter (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMacie2InvitationAccepterConfigBasic(email string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "administrator" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "member" {}
+
+resource "aws_macie2_account" "administrator" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "member" {}
+
+resource "aws_macie2_member" "member" {
+  provider = "awsalternate"
+
+  account_id                            = data.aws_caller_identity.member.account_id
+  email                                 = %[1]q
+  invitation_disable_email_notification = true
+  invite                                = true
+
+  depends_on = [aws_macie2_account.administrator]
+}
+
+resource "aws_macie2_invitation_accepter" "test" {
+  administrator_account_id = data.aws_caller_identity.administrator.account_id
+
+  depends_on = [
+    aws_macie2_account.member,
+    aws_macie2_member.member,
+  ]
+}
+`, email)
+}
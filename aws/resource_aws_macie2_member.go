@@ -0,0 +1,340 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMacie2Member() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2MemberCreate,
+		Read:   resourceAwsMacie2MemberRead,
+		Update: resourceAwsMacie2MemberUpdate,
+		Delete: resourceAwsMacie2MemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"administrator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invitation_disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"invitation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"invited_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(macie2.MacieStatus_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+		},
+	}
+}
+
+func resourceAwsMacie2MemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := d.Get("account_id").(string)
+	input := &macie2.CreateMemberInput{
+		Account: &macie2.AccountDetail{
+			AccountId: aws.String(accountID),
+			Email:     aws.String(d.Get("email").(string)),
+		},
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Member: %s", input)
+	_, err := conn.CreateMember(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Member (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if d.Get("invite").(bool) {
+		if err := macie2InviteMember(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != macie2.MacieStatusEnabled {
+		if err := macie2UpdateMemberStatus(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMacie2MemberRead(d, meta)
+}
+
+func resourceAwsMacie2MemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetMember(&macie2.GetMemberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Member (%s): %w", d.Id(), err)
+	}
+
+	status := aws.StringValue(output.RelationshipStatus)
+
+	if !d.IsNewResource() && status == macie2.RelationshipStatusRemoved {
+		log.Printf("[WARN] Macie Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", output.AccountId)
+	d.Set("administrator_account_id", output.MasterAccountId)
+	d.Set("arn", output.Arn)
+	d.Set("email", output.Email)
+	if output.InvitedAt != nil {
+		d.Set("invited_at", aws.TimeValue(output.InvitedAt).Format(time.RFC3339))
+	} else {
+		d.Set("invited_at", nil)
+	}
+	d.Set("relationship_status", status)
+	if output.UpdatedAt != nil {
+		d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("updated_at", nil)
+	}
+
+	switch status {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusInvited, macie2.RelationshipStatusEmailVerificationInProgress:
+		d.Set("invite", true)
+		d.Set("status", macie2.MacieStatusEnabled)
+	case macie2.RelationshipStatusPaused:
+		d.Set("invite", true)
+		d.Set("status", macie2.MacieStatusPaused)
+	default:
+		d.Set("invite", false)
+		d.Set("status", macie2.MacieStatusEnabled)
+	}
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2MemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := macie2InviteMember(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Disassociating Macie Member (%s)", d.Id())
+			_, err := conn.DisassociateMember(&macie2.DisassociateMemberInput{
+				Id: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error disassociating Macie Member (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("status") {
+		if err := macie2UpdateMemberStatus(conn, d.Id(), d.Get("status").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Member (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2MemberRead(d, meta)
+}
+
+func resourceAwsMacie2MemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Deleting Macie Member (%s)", d.Id())
+	_, err := conn.DeleteMember(&macie2.DeleteMemberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Member (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func macie2InviteMember(conn *macie2.Macie2, d *schema.ResourceData, timeout time.Duration) error {
+	input := &macie2.CreateInvitationsInput{
+		AccountIds:               aws.StringSlice([]string{d.Id()}),
+		DisableEmailNotification: aws.Bool(d.Get("invitation_disable_email_notification").(bool)),
+	}
+
+	if v, ok := d.GetOk("invitation_message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Inviting Macie Member: %s", input)
+	output, err := conn.CreateInvitations(input)
+
+	if err != nil {
+		return fmt.Errorf("error inviting Macie Member (%s): %w", d.Id(), err)
+	}
+
+	if len(output.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("error inviting Macie Member (%s): %s", d.Id(), aws.StringValue(output.UnprocessedAccounts[0].ErrorMessage))
+	}
+
+	getMemberInput := &macie2.GetMemberInput{
+		Id: aws.String(d.Id()),
+	}
+
+	// Wait until e-mail verification finishes.
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		output, err := conn.GetMember(getMemberInput)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		switch status := aws.StringValue(output.RelationshipStatus); status {
+		case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusInvited, macie2.RelationshipStatusPaused:
+			return nil
+		case macie2.RelationshipStatusCreated, macie2.RelationshipStatusEmailVerificationInProgress:
+			return resource.RetryableError(fmt.Errorf("expected Macie Member (%s) to be invited but was in state: %s", d.Id(), status))
+		default:
+			return resource.NonRetryableError(fmt.Errorf("unexpected Macie Member (%s) relationship status: %s", d.Id(), status))
+		}
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err := conn.GetMember(getMemberInput)
+
+		if err != nil {
+			return fmt.Errorf("error reading Macie Member (%s): %w", d.Id(), err)
+		}
+
+		if status := aws.StringValue(output.RelationshipStatus); status != macie2.RelationshipStatusInvited && status != macie2.RelationshipStatusEnabled && status != macie2.RelationshipStatusPaused {
+			return fmt.Errorf("expected Macie Member (%s) to be invited but was in state: %s", d.Id(), status)
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Macie Member (%s) invitation: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func macie2UpdateMemberStatus(conn *macie2.Macie2, id, status string) error {
+	input := &macie2.UpdateMemberSessionInput{
+		Id:     aws.String(id),
+		Status: aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Macie Member: %s", input)
+	_, err := conn.UpdateMemberSession(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Macie Member (%s) status to %s: %w", id, status, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsMacie2Member_basic(t *testing.T) {
+	resourceName := "aws_macie2_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2MemberConfigBasic(accountID, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "macie2", fmt.Sprintf("member/%s", accountID)),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_disappears(t *testing.T) {
+	resourceName := "aws_macie2_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2MemberConfigBasic(accountID, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Member(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_invite(t *testing.T) {
+	resourceName := "aws_macie2_member.test"
+	accountID, email := testAccAWSMacie2MemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2MemberConfigInvite(accountID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
+				),
+			},
+			{
+				Config: testAccAWSMacie2MemberConfigInvite(accountID, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "invited_at"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
+				),
+			},
+			{
+				Config: testAccAWSMacie2MemberConfigInvite(accountID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"invitation_disable_email_notification",
+					"invitation_message",
+				},
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_tags(t *testing.T) {
+	resourceName := "aws_macie2_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMacie2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2MemberConfigTags1(accountID, email, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMacie2MemberConfigTags2(accountID, email, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMacie2MemberConfigTags1(accountID, email, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMacie2MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMacie2MemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_member" {
+			continue
+		}
+
+		output, err := conn.GetMember(&macie2.GetMemberInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.RelationshipStatus) == macie2.RelationshipStatusRemoved {
+			continue
+		}
+
+		return fmt.Errorf("Macie Member (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMacie2MemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Member ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.GetMember(&macie2.GetMemberInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSMacie2MemberConfigBasic(accountID, email string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email)
+}
+
+func testAccAWSMacie2MemberConfigInvite(accountID, email string, invite bool) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id                            = %[1]q
+  email                                 = %[2]q
+  invitation_disable_email_notification = true
+  invitation_message                    = "This is a message of the invitation"
+  invite                                = %[3]t
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, invite)
+}
+
+func testAccAWSMacie2MemberConfigTags1(accountID, email, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, tagKey1, tagValue1)
+}
+
+func testAccAWSMacie2MemberConfigTags2(accountID, email, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestAccAWSMacie2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Account": {
+			"basic":                      testAccAwsMacie2Account_basic,
+			"disappears":                 testAccAwsMacie2Account_disappears,
+			"FindingPublishingFrequency": testAccAwsMacie2Account_FindingPublishingFrequency,
+			"Status":                     testAccAwsMacie2Account_Status,
+		},
+		"ClassificationJob": {
+			"basic":      testAccAwsMacie2ClassificationJob_basic,
+			"disappears": testAccAwsMacie2ClassificationJob_disappears,
+			"JobStatus":  testAccAwsMacie2ClassificationJob_JobStatus,
+			"tags":       testAccAwsMacie2ClassificationJob_tags,
+		},
+		"CustomDataIdentifier": {
+			"basic":      testAccAwsMacie2CustomDataIdentifier_basic,
+			"disappears": testAccAwsMacie2CustomDataIdentifier_disappears,
+			"NamePrefix": testAccAwsMacie2CustomDataIdentifier_NamePrefix,
+			"tags":       testAccAwsMacie2CustomDataIdentifier_tags,
+		},
+		"FindingsFilter": {
+			"basic":      testAccAwsMacie2FindingsFilter_basic,
+			"disappears": testAccAwsMacie2FindingsFilter_disappears,
+			"complete":   testAccAwsMacie2FindingsFilter_complete,
+			"tags":       testAccAwsMacie2FindingsFilter_tags,
+		},
+		"InvitationAccepter": {
+			"basic": testAccAwsMacie2InvitationAccepter_basic,
+		},
+		"Member": {
+			"basic":      testAccAwsMacie2Member_basic,
+			"disappears": testAccAwsMacie2Member_disappears,
+			"invite":     testAccAwsMacie2Member_invite,
+			"tags":       testAccAwsMacie2Member_tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheckAWSMacie2(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	_, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+
+	// Macie does not need to be enabled before running the acceptance tests.
+	if tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return
+	}
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSMacie2MemberFromEnv(t *testing.T) (string, string) {
+	accountID := os.Getenv("AWS_MACIE2_MEMBER_ACCOUNT_ID")
+	if accountID == "" {
+		t.Skip(
+			"Environment variable AWS_MACIE2_MEMBER_ACCOUNT_ID is not set. " +
+				"To properly test inviting Macie member accounts, " +
+				"a valid AWS account ID must be provided.")
+	}
+	email := os.Getenv("AWS_MACIE2_MEMBER_EMAIL")
+	if email == "" {
+		t.Skip(
+			"Environment variable AWS_MACIE2_MEMBER_EMAIL is not set. " +
+				"To properly test inviting Macie member accounts, " +
+				"a valid email associated with the AWS_MACIE2_MEMBER_ACCOUNT_ID must be provided.")
+	}
+	return accountID, email
+}
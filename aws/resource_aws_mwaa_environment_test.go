@@ -0,0 +1,622 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mwaa/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
+		Name: "aws_mwaa_environment",
+		F:    testSweepMwaaEnvironment,
+	})
+}
+
+func testSweepMwaaEnvironment(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).mwaaconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListEnvironmentsPages(&mwaa.ListEnvironmentsInput{}, func(page *mwaa.ListEnvironmentsOutput, lastPage bool) bool {
+		for _, name := range page.Environments {
+			r := resourceAwsMwaaEnvironment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(name))
+
+			log.Printf("[INFO] Deleting MWAA Environment: %s", d.Id())
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting MWAA Environment (%s): %w", d.Id(), err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MWAA Environment sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MWAA Environments: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMwaaEnvironment_basic(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttrSet(resourceName, "airflow_version"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "airflow", "environment/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "dag_s3_path", "dags/"),
+					resource.TestCheckResourceAttr(resourceName, "environment_class", "mw1.small"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "10"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "webserver_access_mode", mwaa.WebserverAccessModePrivateOnly),
+					resource.TestCheckResourceAttrSet(resourceName, "webserver_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "weekly_maintenance_window_start"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_disappears(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMwaaEnvironment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_LoggingConfiguration(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigLoggingConfiguration(rName, mwaa.LoggingLevelInfo, mwaa.LoggingLevelWarning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.log_level", mwaa.LoggingLevelInfo),
+					resource.TestMatchResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.cloud_watch_log_group_arn", regexp.MustCompile(`^arn:[^:]+:logs:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.scheduler_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.scheduler_logs.0.log_level", mwaa.LoggingLevelWarning),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.task_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.task_logs.0.log_level", mwaa.LoggingLevelInfo),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.webserver_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.webserver_logs.0.log_level", mwaa.LoggingLevelWarning),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.log_level", mwaa.LoggingLevelInfo),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigLoggingConfiguration(rName, mwaa.LoggingLevelError, mwaa.LoggingLevelCritical),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.log_level", mwaa.LoggingLevelError),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.scheduler_logs.0.log_level", mwaa.LoggingLevelCritical),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_full(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigFull(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.core.default_task_retries", "1"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.core.parallelism", "16"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_version", "1.10.12"),
+					resource.TestCheckResourceAttr(resourceName, "environment_class", "mw1.medium"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "20"),
+					resource.TestCheckResourceAttr(resourceName, "plugins_s3_path", "plugins.zip"),
+					resource.TestCheckResourceAttrPair(resourceName, "plugins_s3_object_version", "aws_s3_bucket_object.plugins", "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "requirements_s3_path", "requirements.txt"),
+					resource.TestCheckResourceAttrPair(resourceName, "requirements_s3_object_version", "aws_s3_bucket_object.requirements", "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "webserver_access_mode", mwaa.WebserverAccessModePublicOnly),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_window_start", "SAT:03:00"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMwaaEnvironmentExists(n string, v *mwaa.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MWAA Environment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+
+		environment, err := finder.EnvironmentByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *environment
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMwaaEnvironmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mwaa_environment" {
+			continue
+		}
+
+		_, err := finder.EnvironmentByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MWAA Environment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMwaaEnvironmentConfigBase(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+  enable_dns_support   = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "public" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "%[1]s-public-${count.index}"
+  }
+}
+
+resource "aws_subnet" "private" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index + 2)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "%[1]s-private-${count.index}"
+  }
+}
+
+resource "aws_eip" "test" {
+  count = 2
+
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_nat_gateway" "test" {
+  count = 2
+
+  allocation_id = aws_eip.test[count.index].id
+  subnet_id     = aws_subnet.public[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "public" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "public" {
+  count = 2
+
+  subnet_id      = aws_subnet.public[count.index].id
+  route_table_id = aws_route_table.public.id
+}
+
+resource "aws_route_table" "private" {
+  count = 2
+
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block     = "0.0.0.0/0"
+    nat_gateway_id = aws_nat_gateway.test[count.index].id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "private" {
+  count = 2
+
+  subnet_id      = aws_subnet.private[count.index].id
+  route_table_id = aws_route_table.private[count.index].id
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  ingress {
+    from_port = 0
+    to_port   = 0
+    protocol  = "-1"
+    self      = true
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_object" "dags" {
+  bucket       = aws_s3_bucket.test.id
+  acl          = "private"
+  key          = "dags/"
+  content_type = "application/x-directory"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "airflow.${data.aws_partition.current.dns_suffix}",
+          "airflow-env.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "airflow:PublishMetrics",
+      "Resource": "arn:${data.aws_partition.current.partition}:airflow:*:*:environment/%[1]s"
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject*",
+        "s3:GetBucket*",
+        "s3:List*"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogStream",
+        "logs:CreateLogGroup",
+        "logs:PutLogEvents",
+        "logs:GetLogEvents",
+        "logs:GetLogRecord",
+        "logs:GetLogGroupFields",
+        "logs:GetQueryResults",
+        "logs:DescribeLogGroups",
+        "cloudwatch:PutMetricData"
+      ],
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "sqs:ChangeMessageVisibility",
+        "sqs:DeleteMessage",
+        "sqs:GetQueueAttributes",
+        "sqs:GetQueueUrl",
+        "sqs:ReceiveMessage",
+        "sqs:SendMessage"
+      ],
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:airflow-celery-*"
+    }
+  ]
+}
+POLICY
+}
+`, rName))
+}
+
+func testAccAWSMwaaEnvironmentConfigBasic(rName string) string {
+	return composeConfig(testAccAWSMwaaEnvironmentConfigBase(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn               = aws_s3_bucket.test.arn
+}
+`, rName))
+}
+
+func testAccAWSMwaaEnvironmentConfigLoggingConfiguration(rName, logLevel1, logLevel2 string) string {
+	return composeConfig(testAccAWSMwaaEnvironmentConfigBase(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  logging_configuration {
+    dag_processing_logs {
+      enabled   = true
+      log_level = %[2]q
+    }
+
+    scheduler_logs {
+      enabled   = true
+      log_level = %[3]q
+    }
+
+    task_logs {
+      enabled   = true
+      log_level = %[2]q
+    }
+
+    webserver_logs {
+      enabled   = true
+      log_level = %[3]q
+    }
+
+    worker_logs {
+      enabled   = true
+      log_level = %[2]q
+    }
+  }
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn               = aws_s3_bucket.test.arn
+}
+`, rName, logLevel1, logLevel2))
+}
+
+func testAccAWSMwaaEnvironmentConfigFull(rName string) string {
+	return composeConfig(testAccAWSMwaaEnvironmentConfigBase(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket_object" "plugins" {
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "plugins.zip"
+  content = ""
+}
+
+resource "aws_s3_bucket_object" "requirements" {
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "requirements.txt"
+  content = ""
+}
+
+resource "aws_mwaa_environment" "test" {
+  airflow_configuration_options = {
+    "core.default_task_retries" = 1
+    "core.parallelism"          = 16
+  }
+
+  airflow_version    = "1.10.12"
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  environment_class  = "mw1.medium"
+  execution_role_arn = aws_iam_role.test.arn
+  kms_key            = aws_kms_key.test.arn
+  max_workers        = 20
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  plugins_s3_object_version       = aws_s3_bucket_object.plugins.version_id
+  plugins_s3_path                 = aws_s3_bucket_object.plugins.key
+  requirements_s3_object_version  = aws_s3_bucket_object.requirements.version_id
+  requirements_s3_path            = aws_s3_bucket_object.requirements.key
+  source_bucket_arn               = aws_s3_bucket.test.arn
+  webserver_access_mode           = "PUBLIC_ONLY"
+  weekly_maintenance_window_start = "SAT:03:00"
+
+  tags = {
+    Name        = %[1]q
+    Environment = "production"
+  }
+}
+`, rName))
+}
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsNetworkManagerLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerLinkCreate,
+		Read:   resourceAwsNetworkManagerLinkRead,
+		Update: resourceAwsNetworkManagerLinkUpdate,
+		Delete: resourceAwsNetworkManagerLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkManagerGlobalNetworkResourceImport("link"),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"download_speed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"upload_speed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerLinkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.CreateLinkInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteId:          aws.String(d.Get("site_id").(string)),
+	}
+
+	if v, ok := d.GetOk("bandwidth"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Bandwidth = expandNetworkManagerBandwidth(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provider_name"); ok {
+		input.Provider = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().NetworkmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Link: %s", input)
+	output, err := conn.CreateLink(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Link: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Link.LinkId))
+
+	if _, err := waiter.LinkCreated(conn, globalNetworkID, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Link (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerLinkRead(d, meta)
+}
+
+func resourceAwsNetworkManagerLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	link, err := finder.LinkByGlobalNetworkIDAndLinkID(conn, globalNetworkID, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Link (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", link.LinkArn)
+	if link.Bandwidth != nil {
+		if err := d.Set("bandwidth", []interface{}{flattenNetworkManagerBandwidth(link.Bandwidth)}); err != nil {
+			return fmt.Errorf("error setting bandwidth: %w", err)
+		}
+	} else {
+		d.Set("bandwidth", nil)
+	}
+	d.Set("description", link.Description)
+	d.Set("global_network_id", link.GlobalNetworkId)
+	d.Set("provider_name", link.Provider)
+	d.Set("site_id", link.SiteId)
+	d.Set("type", link.Type)
+
+	tags := keyvaluetags.NetworkmanagerKeyValueTags(link.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerLinkUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		globalNetworkID := d.Get("global_network_id").(string)
+		input := &networkmanager.UpdateLinkInput{
+			Description:     aws.String(d.Get("description").(string)),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			LinkId:          aws.String(d.Id()),
+			Provider:        aws.String(d.Get("provider_name").(string)),
+			Type:            aws.String(d.Get("type").(string)),
+		}
+
+		if v, ok := d.GetOk("bandwidth"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Bandwidth = expandNetworkManagerBandwidth(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Link: %s", input)
+		_, err := conn.UpdateLink(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Network Manager Link (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.LinkUpdated(conn, globalNetworkID, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Network Manager Link (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.NetworkmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Network Manager Link (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNetworkManagerLinkRead(d, meta)
+}
+
+func resourceAwsNetworkManagerLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Link (%s)", d.Id())
+	_, err := conn.DeleteLink(&networkmanager.DeleteLinkInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Link (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.LinkDeleted(conn, globalNetworkID, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Link (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerBandwidth(tfMap map[string]interface{}) *networkmanager.Bandwidth {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.Bandwidth{}
+
+	if v, ok := tfMap["download_speed"].(int); ok && v != 0 {
+		apiObject.DownloadSpeed = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["upload_speed"].(int); ok && v != 0 {
+		apiObject.UploadSpeed = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenNetworkManagerBandwidth(apiObject *networkmanager.Bandwidth) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DownloadSpeed; v != nil {
+		tfMap["download_speed"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.UploadSpeed; v != nil {
+		tfMap["upload_speed"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
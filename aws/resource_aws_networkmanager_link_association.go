@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsNetworkManagerLinkAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerLinkAssociationCreate,
+		Read:   resourceAwsNetworkManagerLinkAssociationRead,
+		Delete: resourceAwsNetworkManagerLinkAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerLinkAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	linkID := d.Get("link_id").(string)
+	deviceID := d.Get("device_id").(string)
+	id := tfnetworkmanager.LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID)
+	input := &networkmanager.AssociateLinkInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Link Association: %s", input)
+	_, err := conn.AssociateLink(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Link Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.LinkAssociationCreated(conn, globalNetworkID, linkID, deviceID); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Link Association (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerLinkAssociationRead(d, meta)
+}
+
+func resourceAwsNetworkManagerLinkAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	linkAssociation, err := finder.LinkAssociationByGlobalNetworkIDLinkIDAndDeviceID(conn, globalNetworkID, linkID, deviceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Link Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Link Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("device_id", linkAssociation.DeviceId)
+	d.Set("global_network_id", linkAssociation.GlobalNetworkId)
+	d.Set("link_id", linkAssociation.LinkId)
+
+	return nil
+}
+
+func resourceAwsNetworkManagerLinkAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Link Association (%s)", d.Id())
+	_, err = conn.DisassociateLink(&networkmanager.DisassociateLinkInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Link Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.LinkAssociationDeleted(conn, globalNetworkID, linkID, deviceID); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Link Association (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53HostedZoneDnssec() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53HostedZoneDnssecCreate,
+		Read:   resourceAwsRoute53HostedZoneDnssecRead,
+		Update: resourceAwsRoute53HostedZoneDnssecUpdate,
+		Delete: resourceAwsRoute53HostedZoneDnssecDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"signing_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  tfroute53.ServeSignatureSigning,
+				ValidateFunc: validation.StringInSlice([]string{
+					tfroute53.ServeSignatureSigning,
+					tfroute53.ServeSignatureNotSigning,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53HostedZoneDnssecCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	hostedZoneID := d.Get("hosted_zone_id").(string)
+
+	if err := updateRoute53HostedZoneDnssecSigningStatus(conn, hostedZoneID, d.Get("signing_status").(string)); err != nil {
+		return fmt.Errorf("error creating Route 53 Hosted Zone DNSSEC (%s): %w", hostedZoneID, err)
+	}
+
+	d.SetId(hostedZoneID)
+
+	return resourceAwsRoute53HostedZoneDnssecRead(d, meta)
+}
+
+func resourceAwsRoute53HostedZoneDnssecRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	output, err := finder.HostedZoneDnssec(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone DNSSEC (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone DNSSEC (%s): %w", d.Id(), err)
+	}
+
+	d.Set("hosted_zone_id", d.Id())
+	d.Set("signing_status", output.Status.ServeSignature)
+
+	return nil
+}
+
+func resourceAwsRoute53HostedZoneDnssecUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	if d.HasChange("signing_status") {
+		if err := updateRoute53HostedZoneDnssecSigningStatus(conn, d.Id(), d.Get("signing_status").(string)); err != nil {
+			return fmt.Errorf("error updating Route 53 Hosted Zone DNSSEC (%s) signing status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53HostedZoneDnssecRead(d, meta)
+}
+
+func resourceAwsRoute53HostedZoneDnssecDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	log.Printf("[DEBUG] Disabling Route 53 Hosted Zone DNSSEC (%s)", d.Id())
+	err := updateRoute53HostedZoneDnssecSigningStatus(conn, d.Id(), tfroute53.ServeSignatureNotSigning)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeDNSSECNotFound) || tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Route 53 Hosted Zone DNSSEC (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateRoute53HostedZoneDnssecSigningStatus enables or disables DNSSEC signing for a hosted zone and waits for the change to complete.
+func updateRoute53HostedZoneDnssecSigningStatus(conn *route53.Route53, hostedZoneID string, status string) error {
+	var changeInfo *route53.ChangeInfo
+
+	switch status {
+	case tfroute53.ServeSignatureSigning:
+		output, err := conn.EnableHostedZoneDNSSEC(&route53.EnableHostedZoneDNSSECInput{
+			HostedZoneId: aws.String(hostedZoneID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		changeInfo = output.ChangeInfo
+	case tfroute53.ServeSignatureNotSigning:
+		output, err := conn.DisableHostedZoneDNSSEC(&route53.DisableHostedZoneDNSSECInput{
+			HostedZoneId: aws.String(hostedZoneID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		changeInfo = output.ChangeInfo
+	}
+
+	if changeInfo != nil {
+		if err := waitForRoute53RecordSetToSync(conn, cleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return fmt.Errorf("error waiting for change (%s): %w", aws.StringValue(changeInfo.Id), err)
+		}
+	}
+
+	if _, err := waiter.HostedZoneDnssecStatusUpdated(conn, hostedZoneID, status); err != nil {
+		return fmt.Errorf("error waiting for signing status (%s): %w", status, err)
+	}
+
+	return nil
+}
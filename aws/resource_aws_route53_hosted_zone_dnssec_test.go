@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAwsRoute53HostedZoneDnssec_basic(t *testing.T) {
+	route53ZoneResourceName := "aws_route53_zone.test"
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccRegionPreCheck(t, endpoints.UsEast1RegionID) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53HostedZoneDnssecDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig(rName, zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "hosted_zone_id", route53ZoneResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureSigning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53HostedZoneDnssec_disappears(t *testing.T) {
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccRegionPreCheck(t, endpoints.UsEast1RegionID) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53HostedZoneDnssecDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig(rName, zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53HostedZoneDnssec(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53HostedZoneDnssec_SigningStatus(t *testing.T) {
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccRegionPreCheck(t, endpoints.UsEast1RegionID) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53HostedZoneDnssecDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, zoneName, tfroute53.ServeSignatureNotSigning),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureNotSigning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, zoneName, tfroute53.ServeSignatureSigning),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureSigning),
+				),
+			},
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, zoneName, tfroute53.ServeSignatureNotSigning),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureNotSigning),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRoute53HostedZoneDnssecDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_hosted_zone_dnssec" {
+			continue
+		}
+
+		output, err := finder.HostedZoneDnssec(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Route 53 Hosted Zone DNSSEC (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil && output.Status != nil && aws.StringValue(output.Status.ServeSignature) == tfroute53.ServeSignatureNotSigning {
+			continue
+		}
+
+		return fmt.Errorf("Route 53 Hosted Zone DNSSEC (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsRoute53HostedZoneDnssecExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource %s has not set its id", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		_, err := finder.HostedZoneDnssec(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Route 53 Hosted Zone DNSSEC (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsRoute53HostedZoneDnssecConfig_Base(rName, zoneName string) string {
+	return composeConfig(
+		testAccAwsRoute53KeySigningKeyConfig_Base(rName, zoneName),
+		fmt.Sprintf(`
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+`, rName))
+}
+
+func testAccAwsRoute53HostedZoneDnssecConfig(rName, zoneName string) string {
+	return composeConfig(
+		testAccAwsRoute53HostedZoneDnssecConfig_Base(rName, zoneName),
+		`
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  depends_on = [aws_route53_key_signing_key.test]
+
+  hosted_zone_id = aws_route53_key_signing_key.test.hosted_zone_id
+}
+`)
+}
+
+func testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, zoneName, signingStatus string) string {
+	return composeConfig(
+		testAccAwsRoute53HostedZoneDnssecConfig_Base(rName, zoneName),
+		fmt.Sprintf(`
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  depends_on = [aws_route53_key_signing_key.test]
+
+  hosted_zone_id = aws_route53_key_signing_key.test.hosted_zone_id
+  signing_status = %[1]q
+}
+`, signingStatus))
+}
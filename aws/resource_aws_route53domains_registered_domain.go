@@ -0,0 +1,856 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53DomainsRegisteredDomain() *schema.Resource {
+	contactSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_line_1": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"address_line_2": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"city": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"contact_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(route53domains.ContactType_Values(), false),
+				},
+				"country_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(route53domains.CountryCode_Values(), false),
+				},
+				"email": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 254),
+				},
+				"extra_params": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"fax": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 30),
+				},
+				"first_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"last_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"organization_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"phone_number": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 30),
+				},
+				"state": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"zip_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsRoute53DomainsRegisteredDomainCreate,
+		Read:   resourceAwsRoute53DomainsRegisteredDomainRead,
+		Update: resourceAwsRoute53DomainsRegisteredDomainUpdate,
+		Delete: resourceAwsRoute53DomainsRegisteredDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.OperationSucceededDefaultTimeout),
+			Update: schema.DefaultTimeout(waiter.OperationSucceededDefaultTimeout),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"abuse_contact_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"abuse_contact_phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_contact": contactSchema,
+			"admin_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 6,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_ips": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"registrant_contact": contactSchema,
+			"registrant_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"registrar_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registrar_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reseller": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":         tagsSchema(),
+			"tags_all":     tagsSchemaTrulyComputed(),
+			"tech_contact": contactSchema,
+			"tech_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transfer_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whois_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	// Domains cannot be registered through this resource.
+	// An existing domain registration is adopted and its settings brought in line with the configuration.
+	domainName := d.Get("domain_name").(string)
+	domainDetail, err := finder.DomainDetailByName(conn, domainName)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	d.SetId(aws.StringValue(domainDetail.DomainName))
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var adminContact, registrantContact, techContact *route53domains.ContactDetail
+
+	if v, ok := d.GetOk("admin_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.AdminContact) {
+			adminContact = v
+		}
+	}
+
+	if v, ok := d.GetOk("registrant_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.RegistrantContact) {
+			registrantContact = v
+		}
+	}
+
+	if v, ok := d.GetOk("tech_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.TechContact) {
+			techContact = v
+		}
+	}
+
+	if adminContact != nil || registrantContact != nil || techContact != nil {
+		if err := modifyRoute53DomainsDomainContact(conn, d.Id(), adminContact, registrantContact, techContact, timeout); err != nil {
+			return err
+		}
+	}
+
+	if adminPrivacy, registrantPrivacy, techPrivacy := d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool); adminPrivacy != aws.BoolValue(domainDetail.AdminPrivacy) || registrantPrivacy != aws.BoolValue(domainDetail.RegistrantPrivacy) || techPrivacy != aws.BoolValue(domainDetail.TechPrivacy) {
+		if err := modifyRoute53DomainsDomainContactPrivacy(conn, d.Id(), adminPrivacy, registrantPrivacy, techPrivacy, timeout); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("auto_renew").(bool); v != aws.BoolValue(domainDetail.AutoRenew) {
+		if err := modifyRoute53DomainsDomainAutoRenew(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+		nameservers := expandRoute53DomainsNameservers(v.([]interface{}))
+
+		if !reflect.DeepEqual(nameservers, domainDetail.Nameservers) {
+			if err := modifyRoute53DomainsDomainNameservers(conn, d.Id(), nameservers, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := d.Get("transfer_lock").(bool); v != hasRoute53DomainsDomainTransferLock(aws.StringValueSlice(domainDetail.StatusList)) {
+		if err := modifyRoute53DomainsDomainTransferLock(conn, d.Id(), v, timeout); err != nil {
+			return err
+		}
+	}
+
+	oldTags, err := keyvaluetags.Route53domainsListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	if err := keyvaluetags.Route53domainsUpdateTags(conn, d.Id(), oldTags.Map(), tags.Map()); err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) tags: %w", d.Id(), err)
+	}
+
+	return resourceAwsRoute53DomainsRegisteredDomainRead(d, meta)
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	domainDetail, err := finder.DomainDetailByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Domains Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	d.Set("abuse_contact_email", domainDetail.AbuseContactEmail)
+	d.Set("abuse_contact_phone", domainDetail.AbuseContactPhone)
+	if domainDetail.AdminContact != nil {
+		if err := d.Set("admin_contact", []interface{}{flattenRoute53DomainsContactDetail(domainDetail.AdminContact)}); err != nil {
+			return fmt.Errorf("error setting admin_contact: %w", err)
+		}
+	} else {
+		d.Set("admin_contact", nil)
+	}
+	d.Set("admin_privacy", domainDetail.AdminPrivacy)
+	d.Set("auto_renew", domainDetail.AutoRenew)
+	if domainDetail.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(domainDetail.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("domain_name", domainDetail.DomainName)
+	if domainDetail.ExpirationDate != nil {
+		d.Set("expiration_date", aws.TimeValue(domainDetail.ExpirationDate).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_date", nil)
+	}
+	if err := d.Set("name_server", flattenRoute53DomainsNameservers(domainDetail.Nameservers)); err != nil {
+		return fmt.Errorf("error setting name_server: %w", err)
+	}
+	if domainDetail.RegistrantContact != nil {
+		if err := d.Set("registrant_contact", []interface{}{flattenRoute53DomainsContactDetail(domainDetail.RegistrantContact)}); err != nil {
+			return fmt.Errorf("error setting registrant_contact: %w", err)
+		}
+	} else {
+		d.Set("registrant_contact", nil)
+	}
+	d.Set("registrant_privacy", domainDetail.RegistrantPrivacy)
+	d.Set("registrar_name", domainDetail.RegistrarName)
+	d.Set("registrar_url", domainDetail.RegistrarUrl)
+	d.Set("reseller", domainDetail.Reseller)
+	statusList := aws.StringValueSlice(domainDetail.StatusList)
+	d.Set("status_list", statusList)
+	if domainDetail.TechContact != nil {
+		if err := d.Set("tech_contact", []interface{}{flattenRoute53DomainsContactDetail(domainDetail.TechContact)}); err != nil {
+			return fmt.Errorf("error setting tech_contact: %w", err)
+		}
+	} else {
+		d.Set("tech_contact", nil)
+	}
+	d.Set("tech_privacy", domainDetail.TechPrivacy)
+	d.Set("transfer_lock", hasRoute53DomainsDomainTransferLock(statusList))
+	if domainDetail.UpdatedDate != nil {
+		d.Set("updated_date", aws.TimeValue(domainDetail.UpdatedDate).Format(time.RFC3339))
+	} else {
+		d.Set("updated_date", nil)
+	}
+	d.Set("whois_server", domainDetail.WhoIsServer)
+
+	tags, err := keyvaluetags.Route53domainsListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreDefaultConfig(defaultTagsConfig, keyvaluetags.New(d.Get("tags"))).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChanges("admin_contact", "registrant_contact", "tech_contact") {
+		var adminContact, registrantContact, techContact *route53domains.ContactDetail
+
+		if key := "admin_contact"; d.HasChange(key) {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				adminContact = expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if key := "registrant_contact"; d.HasChange(key) {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				registrantContact = expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if key := "tech_contact"; d.HasChange(key) {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				techContact = expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if adminContact != nil || registrantContact != nil || techContact != nil {
+			if err := modifyRoute53DomainsDomainContact(conn, d.Id(), adminContact, registrantContact, techContact, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChanges("admin_privacy", "registrant_privacy", "tech_privacy") {
+		if err := modifyRoute53DomainsDomainContactPrivacy(conn, d.Id(), d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool), timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("auto_renew") {
+		if err := modifyRoute53DomainsDomainAutoRenew(conn, d.Id(), d.Get("auto_renew").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("name_server") {
+		if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+			if err := modifyRoute53DomainsDomainNameservers(conn, d.Id(), expandRoute53DomainsNameservers(v.([]interface{})), timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("transfer_lock") {
+		if err := modifyRoute53DomainsDomainTransferLock(conn, d.Id(), d.Get("transfer_lock").(bool), timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Route53domainsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Route 53 Domains Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53DomainsRegisteredDomainRead(d, meta)
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Route 53 Domains Domain (%s) not deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainAutoRenew(conn *route53domains.Route53Domains, domainName string, enable bool) error {
+	if enable {
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain (%s) auto-renew", domainName)
+		_, err := conn.EnableDomainAutoRenew(&route53domains.EnableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	} else {
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain (%s) auto-renew", domainName)
+		_, err := conn.DisableDomainAutoRenew(&route53domains.DisableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainContact(conn *route53domains.Route53Domains, domainName string, adminContact, registrantContact, techContact *route53domains.ContactDetail, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainContactInput{
+		AdminContact:      adminContact,
+		DomainName:        aws.String(domainName),
+		RegistrantContact: registrantContact,
+		TechContact:       techContact,
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain (%s) contacts", domainName)
+	output, err := conn.UpdateDomainContact(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) contacts: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) contacts update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainContactPrivacy(conn *route53domains.Route53Domains, domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainContactPrivacyInput{
+		AdminPrivacy:      aws.Bool(adminPrivacy),
+		DomainName:        aws.String(domainName),
+		RegistrantPrivacy: aws.Bool(registrantPrivacy),
+		TechPrivacy:       aws.Bool(techPrivacy),
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain (%s) contact privacy: %s", domainName, input)
+	output, err := conn.UpdateDomainContactPrivacy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) contact privacy: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) contact privacy update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainNameservers(conn *route53domains.Route53Domains, domainName string, nameservers []*route53domains.Nameserver, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainNameserversInput{
+		DomainName:  aws.String(domainName),
+		Nameservers: nameservers,
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain (%s) name servers: %s", domainName, input)
+	output, err := conn.UpdateDomainNameservers(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) name servers: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) name servers update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainTransferLock(conn *route53domains.Route53Domains, domainName string, enable bool, timeout time.Duration) error {
+	var operationID string
+
+	if enable {
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain (%s) transfer lock", domainName)
+		output, err := conn.EnableDomainTransferLock(&route53domains.EnableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	} else {
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain (%s) transfer lock", domainName)
+		output, err := conn.DisableDomainTransferLock(&route53domains.DisableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, operationID, timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) transfer lock update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+// hasRoute53DomainsDomainTransferLock returns whether the domain's EPP status codes include the transfer lock.
+func hasRoute53DomainsDomainTransferLock(statusList []string) bool {
+	for _, v := range statusList {
+		if v == "clientTransferProhibited" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandRoute53DomainsContactDetail(tfMap map[string]interface{}) *route53domains.ContactDetail {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &route53domains.ContactDetail{}
+
+	if v, ok := tfMap["address_line_1"].(string); ok && v != "" {
+		apiObject.AddressLine1 = aws.String(v)
+	}
+
+	if v, ok := tfMap["address_line_2"].(string); ok && v != "" {
+		apiObject.AddressLine2 = aws.String(v)
+	}
+
+	if v, ok := tfMap["city"].(string); ok && v != "" {
+		apiObject.City = aws.String(v)
+	}
+
+	if v, ok := tfMap["contact_type"].(string); ok && v != "" {
+		apiObject.ContactType = aws.String(v)
+	}
+
+	if v, ok := tfMap["country_code"].(string); ok && v != "" {
+		apiObject.CountryCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["email"].(string); ok && v != "" {
+		apiObject.Email = aws.String(v)
+	}
+
+	if v, ok := tfMap["extra_params"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.ExtraParams = expandRoute53DomainsExtraParams(v)
+	}
+
+	if v, ok := tfMap["fax"].(string); ok && v != "" {
+		apiObject.Fax = aws.String(v)
+	}
+
+	if v, ok := tfMap["first_name"].(string); ok && v != "" {
+		apiObject.FirstName = aws.String(v)
+	}
+
+	if v, ok := tfMap["last_name"].(string); ok && v != "" {
+		apiObject.LastName = aws.String(v)
+	}
+
+	if v, ok := tfMap["organization_name"].(string); ok && v != "" {
+		apiObject.OrganizationName = aws.String(v)
+	}
+
+	if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+		apiObject.PhoneNumber = aws.String(v)
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	if v, ok := tfMap["zip_code"].(string); ok && v != "" {
+		apiObject.ZipCode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRoute53DomainsExtraParams(tfMap map[string]interface{}) []*route53domains.ExtraParam {
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	var apiObjects []*route53domains.ExtraParam
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &route53domains.ExtraParam{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandRoute53DomainsNameserver(tfMap map[string]interface{}) *route53domains.Nameserver {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &route53domains.Nameserver{}
+
+	if v, ok := tfMap["glue_ips"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.GlueIps = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRoute53DomainsNameservers(tfList []interface{}) []*route53domains.Nameserver {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*route53domains.Nameserver
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandRoute53DomainsNameserver(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRoute53DomainsContactDetail(apiObject *route53domains.ContactDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AddressLine1; v != nil {
+		tfMap["address_line_1"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AddressLine2; v != nil {
+		tfMap["address_line_2"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.City; v != nil {
+		tfMap["city"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ContactType; v != nil {
+		tfMap["contact_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CountryCode; v != nil {
+		tfMap["country_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Email; v != nil {
+		tfMap["email"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ExtraParams; v != nil {
+		tfMap["extra_params"] = flattenRoute53DomainsExtraParams(v)
+	}
+
+	if v := apiObject.Fax; v != nil {
+		tfMap["fax"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FirstName; v != nil {
+		tfMap["first_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LastName; v != nil {
+		tfMap["last_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OrganizationName; v != nil {
+		tfMap["organization_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PhoneNumber; v != nil {
+		tfMap["phone_number"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.State; v != nil {
+		tfMap["state"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ZipCode; v != nil {
+		tfMap["zip_code"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRoute53DomainsExtraParams(apiObjects []*route53domains.ExtraParam) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func flattenRoute53DomainsNameserver(apiObject *route53domains.Nameserver) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GlueIps; v != nil {
+		tfMap["glue_ips"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRoute53DomainsNameservers(apiObjects []*route53domains.Nameserver) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenRoute53DomainsNameserver(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSRoute53DomainsRegisteredDomain_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"tags":           testAccAWSRoute53DomainsRegisteredDomain_tags,
+		"autoRenew":      testAccAWSRoute53DomainsRegisteredDomain_autoRenew,
+		"contactPrivacy": testAccAWSRoute53DomainsRegisteredDomain_contactPrivacy,
+		"transferLock":   testAccAWSRoute53DomainsRegisteredDomain_transferLock,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccPreCheckAWSRoute53Domains(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).route53domainsconn
+
+	input := &route53domains.ListDomainsInput{}
+
+	_, err := conn.ListDomains(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainNameFromEnv(t *testing.T) string {
+	key := "ROUTE53DOMAINS_DOMAIN_NAME"
+	domainName := os.Getenv(key)
+
+	if domainName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	return domainName
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_tags(t *testing.T) {
+	domainName := testAccAWSRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags2(domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_autoRenew(t *testing.T) {
+	domainName := testAccAWSRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_contactPrivacy(t *testing.T) {
+	domainName := testAccAWSRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_transferLock(t *testing.T) {
+	domainName := testAccAWSRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53DomainsRegisteredDomainDestroy(s *terraform.State) error {
+	// We expect the domain registration to still exist
+	return nil
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, domainName, tagKey1, tagValue1)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTags2(domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+  auto_renew  = %[2]t
+}
+`, domainName, autoRenew)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  admin_privacy      = %[2]t
+  registrant_privacy = %[3]t
+  tech_privacy       = %[4]t
+}
+`, domainName, adminPrivacy, registrantPrivacy, techPrivacy)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName string, transferLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name   = %[1]q
+  transfer_lock = %[2]t
+}
+`, domainName, transferLock)
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAclCreate,
+		Read:   resourceAwsS3BucketAclRead,
+		Update: resourceAwsS3BucketAclUpdate,
+		Delete: resourceAwsS3BucketAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"acl", "grant"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"grant": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Set:          grantHash,
+				ExactlyOneOf: []string{"acl", "grant"},
+				Elem:         s3BucketGrantResource(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAclCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+
+	if err := resourceAwsS3BucketAclPut(meta.(*AWSClient).s3conn, d, bucket); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketAcl(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	// A canned ACL cannot be read back from the bucket, so the explicit grants
+	// are only refreshed when no canned ACL has been configured.
+	if _, ok := d.GetOk("acl"); ok {
+		return nil
+	}
+
+	if err := d.Set("grant", schema.NewSet(grantHash, flattenGrants(output))); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsS3BucketAclPut(meta.(*AWSClient).s3conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// A bucket always has an ACL, so revert to the default canned ACL.
+	input := &s3.PutBucketAclInput{
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.PutBucketAcl(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAclPut(conn *s3.S3, d *schema.ResourceData, bucket string) error {
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else {
+		// Explicit grants replace the whole access control policy, which must include the bucket owner.
+		outputRaw, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+			return conn.GetBucketAcl(&s3.GetBucketAclInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading owner: %w", err)
+		}
+
+		input.AccessControlPolicy = &s3.AccessControlPolicy{
+			Grants: expandS3Grants(d.Get("grant").(*schema.Set).List()),
+			Owner:  outputRaw.(*s3.GetBucketAclOutput).Owner,
+		}
+	}
+
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	return err
+}
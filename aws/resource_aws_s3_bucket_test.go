@@ -684,10 +684,11 @@ func TestAccAWSS3Bucket_UpdateGrant(t *testing.T) {
 				),
 			},
 			{
+				// grant is computed when not configured, so removing it leaves the bucket ACL unchanged.
 				Config: testAccAWSS3BucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
 				),
 			},
 		},
@@ -785,11 +786,12 @@ func TestAccAWSS3Bucket_Website_Simple(t *testing.T) {
 				),
 			},
 			{
+				// website is computed when not configured, so removing it leaves the website configuration unchanged.
 				Config: testAccAWSS3BucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					testAccCheckAWSS3BucketWebsite(resourceName, "index.html", "error.html", "", ""),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -829,11 +831,12 @@ func TestAccAWSS3Bucket_WebsiteRedirect(t *testing.T) {
 				),
 			},
 			{
+				// website is computed when not configured, so removing it leaves the website configuration unchanged.
 				Config: testAccAWSS3BucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "https", "hashicorp.com?my=query"),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -879,12 +882,25 @@ func TestAccAWSS3Bucket_WebsiteRoutingRules(t *testing.T) {
 				ImportStateVerifyIgnore: []string{"force_destroy", "acl", "grant"},
 			},
 			{
+				// website is computed when not configured, so removing it leaves the website configuration unchanged.
 				Config: testAccAWSS3BucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "", ""),
-					testAccCheckAWSS3BucketWebsiteRoutingRules(resourceName, nil),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					testAccCheckAWSS3BucketWebsite(resourceName, "index.html", "error.html", "", ""),
+					testAccCheckAWSS3BucketWebsiteRoutingRules(
+						resourceName,
+						[]*s3.RoutingRule{
+							{
+								Condition: &s3.Condition{
+									KeyPrefixEquals: aws.String("docs/"),
+								},
+								Redirect: &s3.Redirect{
+									ReplaceKeyPrefixWith: aws.String("documents/"),
+								},
+							},
+						},
+					),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -973,10 +989,11 @@ func TestAccAWSS3Bucket_disableDefaultEncryption_whenDefaultEncryptionIsEnabled(
 				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
 			},
 			{
+				// server_side_encryption_configuration is computed when not configured, so removing it leaves default encryption enabled.
 				Config: testAccAWSS3BucketDisableDefaultEncryption(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "server_side_encryption_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption_configuration.#", "1"),
 				),
 			},
 		},
@@ -135,6 +135,11 @@ func resourceAwsSsoAdminManagedPolicyAttachmentDelete(d *schema.ResourceData, me
 		return fmt.Errorf("error detaching Managed Policy (%s) from SSO Permission Set (%s): %w", managedPolicyArn, permissionSetArn, err)
 	}
 
+	// Provision ALL accounts after detaching the managed policy
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -129,5 +129,10 @@ func resourceAwsSsoAdminPermissionSetInlinePolicyDelete(d *schema.ResourceData,
 		return fmt.Errorf("error detaching Inline Policy from SSO Permission Set (%s): %w", permissionSetArn, err)
 	}
 
+	// Provision ALL accounts after deleting the inline policy
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+)
+
+const (
+	retryModeAdaptive = "adaptive"
+	retryModeStandard = "standard"
+)
+
+// retryRule describes an AWS API error, beyond those handled by the
+// AWS Go SDK, that should be retried.
+type retryRule struct {
+	// Operations limits the rule to these API operation names.
+	Operations []string
+
+	// OperationPrefixes limits the rule to API operation names with these prefixes.
+	OperationPrefixes []string
+
+	Code    string
+	Message string
+
+	// MaxRetryCount stops retrying matching errors after this many retries,
+	// for errors that may be legitimate and where the default max_retries
+	// would retry excessively.
+	MaxRetryCount int
+}
+
+func (rule retryRule) matchesOperation(name string) bool {
+	if len(rule.Operations) == 0 && len(rule.OperationPrefixes) == 0 {
+		return true
+	}
+
+	for _, operation := range rule.Operations {
+		if name == operation {
+			return true
+		}
+	}
+
+	for _, prefix := range rule.OperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryRules is a list of retryRule applied in order.
+type retryRules []retryRule
+
+// Handler is a request.Retry handler marking requests retryable
+// when their error matches the first applicable rule.
+func (rules retryRules) Handler(r *request.Request) {
+	for _, rule := range rules {
+		if !rule.matchesOperation(r.Operation.Name) {
+			continue
+		}
+
+		if !isAWSErr(r.Error, rule.Code, rule.Message) {
+			continue
+		}
+
+		if rule.MaxRetryCount > 0 && r.RetryCount >= rule.MaxRetryCount {
+			r.Retryable = aws.Bool(false)
+		} else {
+			r.Retryable = aws.Bool(true)
+		}
+
+		return
+	}
+}
+
+// configureRetries sets the retryer and any client-side rate limiting
+// on a session so that all service clients copied from it share them.
+// Rate limiters are keyed by the AWS API endpoint prefix of each service.
+func (c *Config) configureRetries(sess *session.Session) {
+	// A zero duration keeps the AWS Go SDK default.
+	maxBackoff := time.Duration(c.MaxBackoff) * time.Second
+
+	sess.Config.Retryer = client.DefaultRetryer{
+		NumMaxRetries:    c.MaxRetries,
+		MaxRetryDelay:    maxBackoff,
+		MaxThrottleDelay: maxBackoff,
+	}
+
+	if c.RetryMode != retryModeAdaptive && len(c.RateLimits) == 0 {
+		return
+	}
+
+	limiters := ratelimit.NewLimiters(c.RetryMode == retryModeAdaptive)
+
+	for _, rateLimit := range c.RateLimits {
+		limiters.Add(rateLimit.Service, rateLimit.RequestsPerSecond, rateLimit.Burst)
+	}
+
+	sess.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimit",
+		Fn: func(r *request.Request) {
+			if limiter := limiters.Get(r.ClientInfo.ServiceName); limiter != nil {
+				// A canceled context also fails the subsequent send.
+				_ = limiter.Wait(r.Context())
+			}
+		},
+	})
+
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitThrottled",
+		Fn: func(r *request.Request) {
+			if !r.IsErrorThrottle() {
+				return
+			}
+
+			if limiter := limiters.Get(r.ClientInfo.ServiceName); limiter != nil {
+				limiter.Throttled()
+			}
+		},
+	})
+
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitSucceeded",
+		Fn: func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			if limiter := limiters.Get(r.ClientInfo.ServiceName); limiter != nil {
+				limiter.Succeeded()
+			}
+		},
+	})
+}
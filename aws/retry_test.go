@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRetryRulesHandler(t *testing.T) {
+	rules := retryRules{
+		{
+			Operations: []string{"CreateThing"},
+			Code:       "ConflictException",
+			Message:    "in progress",
+		},
+		{
+			OperationPrefixes: []string{"Describe", "List"},
+			Code:              "AccessDeniedException",
+		},
+		{
+			Operations:    []string{"PutThing"},
+			Code:          "LimitExceededException",
+			MaxRetryCount: 2,
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		Operation     string
+		Error         error
+		RetryCount    int
+		Retryable     *bool
+		ExpectedValue *bool
+	}{
+		{
+			Name:          "no error",
+			Operation:     "CreateThing",
+			ExpectedValue: nil,
+		},
+		{
+			Name:          "operation and message match",
+			Operation:     "CreateThing",
+			Error:         awserr.New("ConflictException", "creation in progress", nil),
+			ExpectedValue: aws.Bool(true),
+		},
+		{
+			Name:          "message mismatch",
+			Operation:     "CreateThing",
+			Error:         awserr.New("ConflictException", "already exists", nil),
+			ExpectedValue: nil,
+		},
+		{
+			Name:          "operation mismatch",
+			Operation:     "DeleteThing",
+			Error:         awserr.New("ConflictException", "creation in progress", nil),
+			ExpectedValue: nil,
+		},
+		{
+			Name:          "operation prefix match",
+			Operation:     "ListThings",
+			Error:         awserr.New("AccessDeniedException", "denied", nil),
+			ExpectedValue: aws.Bool(true),
+		},
+		{
+			Name:          "operation prefix mismatch",
+			Operation:     "UpdateThing",
+			Error:         awserr.New("AccessDeniedException", "denied", nil),
+			ExpectedValue: nil,
+		},
+		{
+			Name:          "under max retry count",
+			Operation:     "PutThing",
+			Error:         awserr.New("LimitExceededException", "", nil),
+			RetryCount:    1,
+			ExpectedValue: aws.Bool(true),
+		},
+		{
+			Name:          "max retry count",
+			Operation:     "PutThing",
+			Error:         awserr.New("LimitExceededException", "", nil),
+			RetryCount:    2,
+			Retryable:     aws.Bool(true),
+			ExpectedValue: aws.Bool(false),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{
+				Error:      testCase.Error,
+				Operation:  &request.Operation{Name: testCase.Operation},
+				RetryCount: testCase.RetryCount,
+				Retryable:  testCase.Retryable,
+			}
+
+			rules.Handler(r)
+
+			if got, expected := r.Retryable, testCase.ExpectedValue; (got == nil) != (expected == nil) || (got != nil && *got != *expected) {
+				t.Errorf("got retryable %v, expected %v", aws.BoolValue(got), aws.BoolValue(expected))
+			}
+		})
+	}
+}
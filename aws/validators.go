@@ -996,7 +996,8 @@ func validateDbEventSubscriptionName(v interface{}, k string) (ws []string, erro
 }
 
 func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error) {
-	// IAM Policy documents need to be valid JSON, and pass legacy parsing
+	// IAM Policy documents need to be valid JSON, pass legacy parsing and
+	// follow the IAM JSON policy grammar
 	value := v.(string)
 	if len(value) < 1 {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy", k))
@@ -1008,6 +1009,12 @@ func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error
 	}
 	if _, err := structure.NormalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	if _, findings := iamPolicyParseDocument(value); len(findings) > 0 {
+		for _, finding := range findings {
+			errors = append(errors, fmt.Errorf("%q contains an invalid IAM policy: %s", k, finding))
+		}
 	}
 	return
 }
@@ -876,22 +876,94 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 			Value:    `    {"xyz": "foo"}`,
 			ErrCount: 1,
 		},
+		{
+			Value:    `{}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"abc":["1","2"]}`,
+			ErrCount: 2,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":"*"}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-18","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"effect":"Allow","Action":"*","Resource":"*"}]}`,
+			ErrCount: 2,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","NotAction":"s3:*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":[["*"]]}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":["*","arn:aws:s3"]}]}`, //lintignore:AWSAT005
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":"AWS"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":[1]}}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEqualz":{"aws:username":"example"}}}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NullIfExists":{"aws:TokenIssueTime":"true"}}}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:username":{"a":"b"}}}}]}`,
+			ErrCount: 1,
+		},
 	}
 
 	for _, tc := range invalidCases {
 		_, errors := validateIAMPolicyJson(tc.Value, "json")
 		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected %q to trigger a validation error.", tc.Value)
+			t.Fatalf("Expected %q to trigger %d validation error(s), got %d: %v", tc.Value, tc.ErrCount, len(errors), errors)
 		}
 	}
 
 	validCases := []testCases{
 		{
-			Value:    `{}`,
+			Value:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`,
 			ErrCount: 0,
 		},
 		{
-			Value:    `{"abc":["1","2"]}`,
+			Value:    `{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"","Effect":"Deny","NotAction":["s3:Get*","ec2:*"],"NotResource":["arn:aws:s3:::example/${aws:username}/*"]}]}`, //lintignore:AWSAT005
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com","AWS":["*"]}}]}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotPrincipal":"*","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false},"NumericLessThanIfExists":{"s3:max-keys":[10]},"ForAnyValue:StringLike":{"aws:TagKeys":["a*"]}}}]}`,
 			ErrCount: 0,
 		},
 	}